@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/lognarly/hubspot-go/hubspot"
+)
+
+type config struct {
+	Package    string
+	ObjectType string
+	TypeName   string
+	CustomOnly bool
+	Archived   bool
+}
+
+type field struct {
+	Name     string
+	GoType   string
+	JSONName string
+	Comment  string
+	Enum     *enum
+}
+
+type enum struct {
+	TypeName string
+	Values   []enumValue
+}
+
+type enumValue struct {
+	Name  string
+	Value string
+	Label string
+}
+
+// generate renders the property struct for properties as gofmt'ed Go source.
+func generate(cfg config, properties []hubspot.Property) ([]byte, error) {
+	singular := singularName(cfg.ObjectType)
+	typeName := cfg.TypeName
+	if typeName == "" {
+		typeName = singular + "Properties"
+	}
+
+	sorted := make([]hubspot.Property, 0, len(properties))
+	for _, p := range properties {
+		if cfg.CustomOnly && p.HubspotDefined {
+			continue
+		}
+		if p.Archived && !cfg.Archived {
+			continue
+		}
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	// The typed property values live in package hubspot
+	qualifier := "hubspot."
+	if cfg.Package == "hubspot" {
+		qualifier = ""
+	}
+
+	// Raw holds the properties without a field, e.g. custom properties created after generation
+	used := map[string]bool{"Raw": true}
+	usedConsts := map[string]bool{}
+	fields := make([]field, 0, len(sorted))
+	for _, p := range sorted {
+		f := field{
+			Name:     unique(goName(p.Name), used),
+			GoType:   goType(p, qualifier),
+			JSONName: p.Name,
+			Comment:  strings.Join(strings.Fields(p.Label), " "),
+		}
+		if p.Type == hubspot.PropertyTypeEnumeration && len(p.Options) > 0 && p.FieldType != hubspot.FieldTypeCheckbox {
+			e := &enum{TypeName: unique(singular+f.Name, usedConsts)}
+			for _, o := range p.Options {
+				e.Values = append(e.Values, enumValue{
+					Name:  unique(e.TypeName+goName(o.Value), usedConsts),
+					Value: o.Value,
+					Label: strings.Join(strings.Fields(o.Label), " "),
+				})
+			}
+			f.Enum = e
+			f.GoType = e.TypeName
+		}
+		fields = append(fields, f)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by hubspot-gen for %s. DO NOT EDIT.\n\n", cfg.ObjectType)
+	fmt.Fprintf(&buf, "package %s\n\n", cfg.Package)
+	if qualifier != "" {
+		buf.WriteString("import \"github.com/lognarly/hubspot-go/hubspot\"\n\n")
+	}
+
+	for _, f := range fields {
+		if f.Enum == nil {
+			continue
+		}
+		fmt.Fprintf(&buf, "type %s string\n\nconst (\n", f.Enum.TypeName)
+		for _, v := range f.Enum.Values {
+			fmt.Fprintf(&buf, "\t%s %s = %q", v.Name, f.Enum.TypeName, v.Value)
+			if v.Label != "" && v.Label != v.Value {
+				fmt.Fprintf(&buf, " // %s", v.Label)
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	fmt.Fprintf(&buf, "type %s struct {\n", typeName)
+	for _, f := range fields {
+		fmt.Fprintf(&buf, "\t%s %s `json:\"%s,omitempty\"`", f.Name, f.GoType, f.JSONName)
+		if f.Comment != "" {
+			fmt.Fprintf(&buf, " // %s", f.Comment)
+		}
+		buf.WriteString("\n")
+	}
+	fmt.Fprintf(&buf, "\n\tRaw %sRawProperties `json:\"-\"`\n", qualifier)
+	buf.WriteString("}\n\n")
+
+	// Like the built-in properties structs, properties without a field round-trip through Raw and
+	// typed values which are not set are left out
+	fmt.Fprintf(&buf, "func (p %s) MarshalJSON() ([]byte, error) {\n", typeName)
+	fmt.Fprintf(&buf, "\ttype properties %s\n", typeName)
+	fmt.Fprintf(&buf, "\treturn %sMarshalPropertiesWithRaw(properties(p), p.Raw)\n}\n\n", qualifier)
+	fmt.Fprintf(&buf, "func (p *%s) UnmarshalJSON(b []byte) error {\n", typeName)
+	fmt.Fprintf(&buf, "\ttype properties %s\n", typeName)
+	fmt.Fprintf(&buf, "\treturn %sUnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)\n}\n", qualifier)
+
+	return format.Source(buf.Bytes())
+}
+
+// goType maps a property definition to the Go type of its field, using the typed property values
+// of package hubspot for everything but strings.
+func goType(p hubspot.Property, qualifier string) string {
+	switch p.Type {
+	case hubspot.PropertyTypeNumber:
+		return qualifier + "Number"
+	case hubspot.PropertyTypeDateTime:
+		return qualifier + "DateTime"
+	case hubspot.PropertyTypeDate:
+		return qualifier + "Date"
+	case hubspot.PropertyTypeBool:
+		return qualifier + "Bool"
+	case hubspot.PropertyTypeEnumeration:
+		if p.FieldType == hubspot.FieldTypeCheckbox {
+			return qualifier + "MultiSelect"
+		}
+	}
+	return "string"
+}
+
+// goName converts a property name or option value such as hs_lead_status into an exported identifier.
+func goName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	name := b.String()
+	if name == "" {
+		return "Empty"
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		name = "P" + name
+	}
+	return name
+}
+
+// singularName derives the type prefix from an object type, e.g. line_items becomes LineItem.
+func singularName(objectType string) string {
+	name := goName(objectType)
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
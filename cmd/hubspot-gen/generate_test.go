@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestGenerateGolden(t *testing.T) {
+	var stdout bytes.Buffer
+	if err := run([]string{"-object", "deals", "-input", filepath.Join("testdata", "deals.json"), "-package", "crm"}, &stdout); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "deals.golden")
+	if *update {
+		if err := os.WriteFile(golden, stdout.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stdout.Bytes(), want) {
+		t.Errorf("generated source differs from %s, run go test -update to accept it:\n%s", golden, stdout.String())
+	}
+}
+
+func TestGenerateOptions(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "deal_properties.go")
+	args := []string{"-object", "deals", "-input", filepath.Join("testdata", "deals.json"), "-custom-only", "-archived", "-type", "CustomDealProperties", "-out", out}
+	if err := run(args, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Compare with the alignment of gofmt collapsed
+	src := strings.Join(strings.Fields(string(b)), " ")
+
+	for _, want := range []string{
+		"package hubspot ",
+		"type CustomDealProperties struct",
+		"LegacyScore Number `json:\"legacy_score,omitempty\"`",
+		"Raw2 string `json:\"raw,omitempty\"`",
+		"Raw RawProperties `json:\"-\"`",
+		"return MarshalPropertiesWithRaw(properties(p), p.Raw)",
+		"return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated source does not contain %q:\n%s", want, src)
+		}
+	}
+	for _, unwanted := range []string{"import", "dealname", "DealDealtype"} {
+		if strings.Contains(src, unwanted) {
+			t.Errorf("generated source contains %q:\n%s", unwanted, src)
+		}
+	}
+}
+
+func TestRunRequiresObject(t *testing.T) {
+	if err := run([]string{"-input", filepath.Join("testdata", "deals.json")}, nil); err == nil {
+		t.Fatal("expected an error without -object")
+	}
+}
+
+func TestReadDefinitionsBareArray(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deals.json")
+	if err := os.WriteFile(path, []byte(`[{"name":"dealname","type":"string"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	properties, err := readDefinitions(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(properties) != 1 || properties[0].Name != "dealname" {
+		t.Fatalf("properties = %+v", properties)
+	}
+}
+
+func TestWriteDefinitionsRoundTrip(t *testing.T) {
+	properties, err := readDefinitions(filepath.Join("testdata", "deals.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "export.json")
+	if err := writeDefinitions(path, properties); err != nil {
+		t.Fatal(err)
+	}
+	exported, err := readDefinitions(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != len(properties) || exported[6].Options[1].Value != "americas" {
+		t.Fatalf("exported %+v", exported)
+	}
+}
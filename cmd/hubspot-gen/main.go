@@ -0,0 +1,128 @@
+// Command hubspot-gen generates Go property structs and enumeration constants from a portal's
+// property definitions.
+//
+// Definitions are read either live from the Properties API or from a JSON file saved earlier,
+// so generation also works offline:
+//
+//	HUBSPOT_TOKEN=... hubspot-gen -object deals -export deals.json -out deal_properties.go
+//	hubspot-gen -object deals -input deals.json -package crm -out deal_properties.go
+//
+// The input file holds the response of GET /crm/v3/properties/{objectType}, either as the
+// {"results": [...]} object or as a bare array.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lognarly/hubspot-go/hubspot"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fail(err)
+	}
+}
+
+// run parses args and writes the generated source to -out, or to stdout when -out is not set.
+func run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("hubspot-gen", flag.ContinueOnError)
+	var (
+		objectType  = flags.String("object", "", "object type the definitions belong to, e.g. contacts or 2-1234567 (required)")
+		input       = flags.String("input", "", "read definitions from this JSON file instead of the API")
+		export      = flags.String("export", "", "also save the definitions fetched from the API to this JSON file")
+		token       = flags.String("token", os.Getenv("HUBSPOT_TOKEN"), "private app token, defaults to $HUBSPOT_TOKEN")
+		out         = flags.String("out", "", "output file, defaults to stdout")
+		pkg         = flags.String("package", "hubspot", "package name of the generated file")
+		typeName    = flags.String("type", "", "name of the generated struct, defaults to e.g. ContactProperties")
+		customOnly  = flags.Bool("custom-only", false, "only generate properties which are not HubSpot defined")
+		withArchive = flags.Bool("archived", false, "include archived properties")
+	)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *objectType == "" {
+		return fmt.Errorf("-object is required")
+	}
+
+	var properties []hubspot.Property
+	var err error
+	if *input != "" {
+		properties, err = readDefinitions(*input)
+	} else {
+		properties, err = fetchDefinitions(*token, *objectType, *withArchive)
+		if err == nil && *export != "" {
+			err = writeDefinitions(*export, properties)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(config{
+		Package:    *pkg,
+		ObjectType: *objectType,
+		TypeName:   *typeName,
+		CustomOnly: *customOnly,
+		Archived:   *withArchive,
+	}, properties)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+func fetchDefinitions(token string, objectType string, archived bool) ([]hubspot.Property, error) {
+	client, err := hubspot.NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	pl, err := client.Properties.List(ctx, hubspot.ObjectType(objectType), &hubspot.PropertyListQuery{Archived: archived})
+	if err != nil {
+		return nil, err
+	}
+	return pl.Results, nil
+}
+
+func readDefinitions(path string) ([]hubspot.Property, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pl := &hubspot.PropertyList{}
+	if err = json.Unmarshal(b, pl); err == nil {
+		return pl.Results, nil
+	}
+	var properties []hubspot.Property
+	if err = json.Unmarshal(b, &properties); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return properties, nil
+}
+
+func writeDefinitions(path string, properties []hubspot.Property) error {
+	b, err := json.MarshalIndent(hubspot.PropertyList{Results: properties}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "hubspot-gen:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,243 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The association types defined by HubSpot. Each pair of object types has an unlabeled default type, some
+// also have a Primary type or, between companies, a Parent and Child type.
+const (
+	CompanyToContactPrimaryTypeId HubspotAssociationTypeId = 2
+	CompanyToContactTypeId        HubspotAssociationTypeId = 280
+	CompanyToDealPrimaryTypeId    HubspotAssociationTypeId = 6
+	CompanyToDealTypeId           HubspotAssociationTypeId = 342
+	CompanyToTicketPrimaryTypeId  HubspotAssociationTypeId = 25
+	CompanyToTicketTypeId         HubspotAssociationTypeId = 340
+	CompanyToCompanyParentTypeId  HubspotAssociationTypeId = 13
+	CompanyToCompanyChildTypeId   HubspotAssociationTypeId = 14
+	CompanyToCompanyTypeId        HubspotAssociationTypeId = 450
+	CompanyToCallTypeId           HubspotAssociationTypeId = 181
+	CompanyToEmailTypeId          HubspotAssociationTypeId = 185
+	CompanyToMeetingTypeId        HubspotAssociationTypeId = 187
+	CompanyToNoteTypeId           HubspotAssociationTypeId = 189
+	CompanyToTaskTypeId           HubspotAssociationTypeId = 191
+	CompanyToCommunicationTypeId  HubspotAssociationTypeId = 88
+	CompanyToPostalMailTypeId     HubspotAssociationTypeId = 460
+	CompanyToQuoteTypeId          HubspotAssociationTypeId = 72
+
+	ContactToCompanyPrimaryTypeId HubspotAssociationTypeId = 1
+	ContactToCompanyTypeId        HubspotAssociationTypeId = 279
+	ContactToContactTypeId        HubspotAssociationTypeId = 449
+	ContactToDealTypeId           HubspotAssociationTypeId = 4
+	ContactToTicketTypeId         HubspotAssociationTypeId = 15
+	ContactToCallTypeId           HubspotAssociationTypeId = 193
+	ContactToEmailTypeId          HubspotAssociationTypeId = 197
+	ContactToMeetingTypeId        HubspotAssociationTypeId = 199
+	ContactToNoteTypeId           HubspotAssociationTypeId = 201
+	ContactToTaskTypeId           HubspotAssociationTypeId = 203
+	ContactToCommunicationTypeId  HubspotAssociationTypeId = 82
+	ContactToPostalMailTypeId     HubspotAssociationTypeId = 454
+	ContactToQuoteTypeId          HubspotAssociationTypeId = 70
+
+	DealToContactTypeId        HubspotAssociationTypeId = 3
+	DealToCompanyPrimaryTypeId HubspotAssociationTypeId = 5
+	DealToCompanyTypeId        HubspotAssociationTypeId = 341
+	DealToDealTypeId           HubspotAssociationTypeId = 451
+	DealToTicketTypeId         HubspotAssociationTypeId = 27
+	DealToCallTypeId           HubspotAssociationTypeId = 205
+	DealToEmailTypeId          HubspotAssociationTypeId = 209
+	DealToMeetingTypeId        HubspotAssociationTypeId = 211
+	DealToNoteTypeId           HubspotAssociationTypeId = 213
+	DealToTaskTypeId           HubspotAssociationTypeId = 215
+	DealToCommunicationTypeId  HubspotAssociationTypeId = 86
+	DealToPostalMailTypeId     HubspotAssociationTypeId = 458
+	DealToLineItemTypeId       HubspotAssociationTypeId = 19
+	DealToQuoteTypeId          HubspotAssociationTypeId = 63
+
+	TicketToContactTypeId        HubspotAssociationTypeId = 16
+	TicketToCompanyPrimaryTypeId HubspotAssociationTypeId = 26
+	TicketToCompanyTypeId        HubspotAssociationTypeId = 339
+	TicketToDealTypeId           HubspotAssociationTypeId = 28
+	TicketToTicketTypeId         HubspotAssociationTypeId = 452
+	TicketToCallTypeId           HubspotAssociationTypeId = 219
+	TicketToEmailTypeId          HubspotAssociationTypeId = 223
+	TicketToMeetingTypeId        HubspotAssociationTypeId = 225
+	TicketToNoteTypeId           HubspotAssociationTypeId = 227
+	TicketToTaskTypeId           HubspotAssociationTypeId = 229
+	TicketToCommunicationTypeId  HubspotAssociationTypeId = 84
+	TicketToPostalMailTypeId     HubspotAssociationTypeId = 456
+
+	CallToContactTypeId HubspotAssociationTypeId = 194
+	CallToCompanyTypeId HubspotAssociationTypeId = 182
+	CallToDealTypeId    HubspotAssociationTypeId = 206
+	CallToTicketTypeId  HubspotAssociationTypeId = 220
+
+	EmailToContactTypeId HubspotAssociationTypeId = 198
+	EmailToCompanyTypeId HubspotAssociationTypeId = 186
+	EmailToDealTypeId    HubspotAssociationTypeId = 210
+	EmailToTicketTypeId  HubspotAssociationTypeId = 224
+
+	MeetingToContactTypeId HubspotAssociationTypeId = 200
+	MeetingToCompanyTypeId HubspotAssociationTypeId = 188
+	MeetingToDealTypeId    HubspotAssociationTypeId = 212
+	MeetingToTicketTypeId  HubspotAssociationTypeId = 226
+
+	NoteToContactTypeId HubspotAssociationTypeId = 202
+	NoteToCompanyTypeId HubspotAssociationTypeId = 190
+	NoteToDealTypeId    HubspotAssociationTypeId = 214
+	NoteToTicketTypeId  HubspotAssociationTypeId = 228
+
+	TaskToContactTypeId HubspotAssociationTypeId = 204
+	TaskToCompanyTypeId HubspotAssociationTypeId = 192
+	TaskToDealTypeId    HubspotAssociationTypeId = 216
+	TaskToTicketTypeId  HubspotAssociationTypeId = 230
+
+	CommunicationToContactTypeId HubspotAssociationTypeId = 81
+	CommunicationToCompanyTypeId HubspotAssociationTypeId = 87
+	CommunicationToDealTypeId    HubspotAssociationTypeId = 85
+	CommunicationToTicketTypeId  HubspotAssociationTypeId = 83
+
+	PostalMailToContactTypeId HubspotAssociationTypeId = 453
+	PostalMailToCompanyTypeId HubspotAssociationTypeId = 459
+	PostalMailToDealTypeId    HubspotAssociationTypeId = 457
+	PostalMailToTicketTypeId  HubspotAssociationTypeId = 455
+
+	QuoteToContactTypeId  HubspotAssociationTypeId = 69
+	QuoteToCompanyTypeId  HubspotAssociationTypeId = 71
+	QuoteToDealTypeId     HubspotAssociationTypeId = 64
+	QuoteToLineItemTypeId HubspotAssociationTypeId = 67
+
+	LineItemToDealTypeId  HubspotAssociationTypeId = 20
+	LineItemToQuoteTypeId HubspotAssociationTypeId = 68
+)
+
+var ErrAssociationLabelNotFound = errors.New("hubspot: association label not found")
+
+// associationTypeKey identifies a HubSpot defined association type by object type names and lower case label.
+type associationTypeKey struct {
+	from  ObjectType
+	to    ObjectType
+	label string
+}
+
+var hubspotDefinedAssociationTypes = map[associationTypeKey]HubspotAssociationTypeId{
+	{ObjectTypeCompanies, ObjectTypeContacts, "primary"}: CompanyToContactPrimaryTypeId,
+	{ObjectTypeCompanies, ObjectTypeContacts, ""}:        CompanyToContactTypeId,
+	{ObjectTypeCompanies, ObjectTypeDeals, "primary"}:    CompanyToDealPrimaryTypeId,
+	{ObjectTypeCompanies, ObjectTypeDeals, ""}:           CompanyToDealTypeId,
+	{ObjectTypeCompanies, ObjectTypeTickets, "primary"}:  CompanyToTicketPrimaryTypeId,
+	{ObjectTypeCompanies, ObjectTypeTickets, ""}:         CompanyToTicketTypeId,
+	{ObjectTypeCompanies, ObjectTypeCompanies, "parent"}: CompanyToCompanyParentTypeId,
+	{ObjectTypeCompanies, ObjectTypeCompanies, "child"}:  CompanyToCompanyChildTypeId,
+	{ObjectTypeCompanies, ObjectTypeCompanies, ""}:       CompanyToCompanyTypeId,
+	{ObjectTypeCompanies, ObjectTypeCalls, ""}:           CompanyToCallTypeId,
+	{ObjectTypeCompanies, ObjectTypeEmails, ""}:          CompanyToEmailTypeId,
+	{ObjectTypeCompanies, ObjectTypeMeetings, ""}:        CompanyToMeetingTypeId,
+	{ObjectTypeCompanies, ObjectTypeNotes, ""}:           CompanyToNoteTypeId,
+	{ObjectTypeCompanies, ObjectTypeTasks, ""}:           CompanyToTaskTypeId,
+	{ObjectTypeCompanies, ObjectTypeCommunications, ""}:  CompanyToCommunicationTypeId,
+	{ObjectTypeCompanies, ObjectTypePostalMail, ""}:      CompanyToPostalMailTypeId,
+	{ObjectTypeCompanies, ObjectTypeQuotes, ""}:          CompanyToQuoteTypeId,
+	{ObjectTypeContacts, ObjectTypeCompanies, "primary"}: ContactToCompanyPrimaryTypeId,
+	{ObjectTypeContacts, ObjectTypeCompanies, ""}:        ContactToCompanyTypeId,
+	{ObjectTypeContacts, ObjectTypeContacts, ""}:         ContactToContactTypeId,
+	{ObjectTypeContacts, ObjectTypeDeals, ""}:            ContactToDealTypeId,
+	{ObjectTypeContacts, ObjectTypeTickets, ""}:          ContactToTicketTypeId,
+	{ObjectTypeContacts, ObjectTypeCalls, ""}:            ContactToCallTypeId,
+	{ObjectTypeContacts, ObjectTypeEmails, ""}:           ContactToEmailTypeId,
+	{ObjectTypeContacts, ObjectTypeMeetings, ""}:         ContactToMeetingTypeId,
+	{ObjectTypeContacts, ObjectTypeNotes, ""}:            ContactToNoteTypeId,
+	{ObjectTypeContacts, ObjectTypeTasks, ""}:            ContactToTaskTypeId,
+	{ObjectTypeContacts, ObjectTypeCommunications, ""}:   ContactToCommunicationTypeId,
+	{ObjectTypeContacts, ObjectTypePostalMail, ""}:       ContactToPostalMailTypeId,
+	{ObjectTypeContacts, ObjectTypeQuotes, ""}:           ContactToQuoteTypeId,
+	{ObjectTypeDeals, ObjectTypeContacts, ""}:            DealToContactTypeId,
+	{ObjectTypeDeals, ObjectTypeCompanies, "primary"}:    DealToCompanyPrimaryTypeId,
+	{ObjectTypeDeals, ObjectTypeCompanies, ""}:           DealToCompanyTypeId,
+	{ObjectTypeDeals, ObjectTypeDeals, ""}:               DealToDealTypeId,
+	{ObjectTypeDeals, ObjectTypeTickets, ""}:             DealToTicketTypeId,
+	{ObjectTypeDeals, ObjectTypeCalls, ""}:               DealToCallTypeId,
+	{ObjectTypeDeals, ObjectTypeEmails, ""}:              DealToEmailTypeId,
+	{ObjectTypeDeals, ObjectTypeMeetings, ""}:            DealToMeetingTypeId,
+	{ObjectTypeDeals, ObjectTypeNotes, ""}:               DealToNoteTypeId,
+	{ObjectTypeDeals, ObjectTypeTasks, ""}:               DealToTaskTypeId,
+	{ObjectTypeDeals, ObjectTypeCommunications, ""}:      DealToCommunicationTypeId,
+	{ObjectTypeDeals, ObjectTypePostalMail, ""}:          DealToPostalMailTypeId,
+	{ObjectTypeDeals, ObjectTypeLineItems, ""}:           DealToLineItemTypeId,
+	{ObjectTypeDeals, ObjectTypeQuotes, ""}:              DealToQuoteTypeId,
+	{ObjectTypeTickets, ObjectTypeContacts, ""}:          TicketToContactTypeId,
+	{ObjectTypeTickets, ObjectTypeCompanies, "primary"}:  TicketToCompanyPrimaryTypeId,
+	{ObjectTypeTickets, ObjectTypeCompanies, ""}:         TicketToCompanyTypeId,
+	{ObjectTypeTickets, ObjectTypeDeals, ""}:             TicketToDealTypeId,
+	{ObjectTypeTickets, ObjectTypeTickets, ""}:           TicketToTicketTypeId,
+	{ObjectTypeTickets, ObjectTypeCalls, ""}:             TicketToCallTypeId,
+	{ObjectTypeTickets, ObjectTypeEmails, ""}:            TicketToEmailTypeId,
+	{ObjectTypeTickets, ObjectTypeMeetings, ""}:          TicketToMeetingTypeId,
+	{ObjectTypeTickets, ObjectTypeNotes, ""}:             TicketToNoteTypeId,
+	{ObjectTypeTickets, ObjectTypeTasks, ""}:             TicketToTaskTypeId,
+	{ObjectTypeTickets, ObjectTypeCommunications, ""}:    TicketToCommunicationTypeId,
+	{ObjectTypeTickets, ObjectTypePostalMail, ""}:        TicketToPostalMailTypeId,
+	{ObjectTypeCalls, ObjectTypeContacts, ""}:            CallToContactTypeId,
+	{ObjectTypeCalls, ObjectTypeCompanies, ""}:           CallToCompanyTypeId,
+	{ObjectTypeCalls, ObjectTypeDeals, ""}:               CallToDealTypeId,
+	{ObjectTypeCalls, ObjectTypeTickets, ""}:             CallToTicketTypeId,
+	{ObjectTypeEmails, ObjectTypeContacts, ""}:           EmailToContactTypeId,
+	{ObjectTypeEmails, ObjectTypeCompanies, ""}:          EmailToCompanyTypeId,
+	{ObjectTypeEmails, ObjectTypeDeals, ""}:              EmailToDealTypeId,
+	{ObjectTypeEmails, ObjectTypeTickets, ""}:            EmailToTicketTypeId,
+	{ObjectTypeMeetings, ObjectTypeContacts, ""}:         MeetingToContactTypeId,
+	{ObjectTypeMeetings, ObjectTypeCompanies, ""}:        MeetingToCompanyTypeId,
+	{ObjectTypeMeetings, ObjectTypeDeals, ""}:            MeetingToDealTypeId,
+	{ObjectTypeMeetings, ObjectTypeTickets, ""}:          MeetingToTicketTypeId,
+	{ObjectTypeNotes, ObjectTypeContacts, ""}:            NoteToContactTypeId,
+	{ObjectTypeNotes, ObjectTypeCompanies, ""}:           NoteToCompanyTypeId,
+	{ObjectTypeNotes, ObjectTypeDeals, ""}:               NoteToDealTypeId,
+	{ObjectTypeNotes, ObjectTypeTickets, ""}:             NoteToTicketTypeId,
+	{ObjectTypeTasks, ObjectTypeContacts, ""}:            TaskToContactTypeId,
+	{ObjectTypeTasks, ObjectTypeCompanies, ""}:           TaskToCompanyTypeId,
+	{ObjectTypeTasks, ObjectTypeDeals, ""}:               TaskToDealTypeId,
+	{ObjectTypeTasks, ObjectTypeTickets, ""}:             TaskToTicketTypeId,
+	{ObjectTypeCommunications, ObjectTypeContacts, ""}:   CommunicationToContactTypeId,
+	{ObjectTypeCommunications, ObjectTypeCompanies, ""}:  CommunicationToCompanyTypeId,
+	{ObjectTypeCommunications, ObjectTypeDeals, ""}:      CommunicationToDealTypeId,
+	{ObjectTypeCommunications, ObjectTypeTickets, ""}:    CommunicationToTicketTypeId,
+	{ObjectTypePostalMail, ObjectTypeContacts, ""}:       PostalMailToContactTypeId,
+	{ObjectTypePostalMail, ObjectTypeCompanies, ""}:      PostalMailToCompanyTypeId,
+	{ObjectTypePostalMail, ObjectTypeDeals, ""}:          PostalMailToDealTypeId,
+	{ObjectTypePostalMail, ObjectTypeTickets, ""}:        PostalMailToTicketTypeId,
+	{ObjectTypeQuotes, ObjectTypeContacts, ""}:           QuoteToContactTypeId,
+	{ObjectTypeQuotes, ObjectTypeCompanies, ""}:          QuoteToCompanyTypeId,
+	{ObjectTypeQuotes, ObjectTypeDeals, ""}:              QuoteToDealTypeId,
+	{ObjectTypeQuotes, ObjectTypeLineItems, ""}:          QuoteToLineItemTypeId,
+	{ObjectTypeLineItems, ObjectTypeDeals, ""}:           LineItemToDealTypeId,
+	{ObjectTypeLineItems, ObjectTypeQuotes, ""}:          LineItemToQuoteTypeId,
+}
+
+// HubSpotDefinedAssociationType returns the HubSpot defined association type between two object types, which
+// may be given by name or objectTypeId. An empty label selects the unlabeled default type.
+func HubSpotDefinedAssociationType(fromObjectType ObjectType, toObjectType ObjectType, label string) (HubspotAssociationTypeId, bool) {
+	typeId, ok := hubspotDefinedAssociationTypes[associationTypeKey{fromObjectType.Name(), toObjectType.Name(), strings.ToLower(label)}]
+	return typeId, ok
+}
+
+// LookupType resolves an association label to its type. HubSpot defined types are resolved locally,
+// any other label is looked up with ReadDefinition. An empty label selects the unlabeled default type.
+func (a *associations) LookupType(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType, label string) (*AssociationCreateOptions, error) {
+	if typeId, ok := HubSpotDefinedAssociationType(fromObjectType, toObjectType, label); ok {
+		return &AssociationCreateOptions{Category: HubSpotDefined, TypeId: typeId}, nil
+	}
+
+	definitions, err := a.ReadDefinition(ctx, fromObjectType, toObjectType)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range definitions.Results {
+		if strings.EqualFold(d.Label, label) {
+			return &AssociationCreateOptions{Category: d.Category, TypeId: HubspotAssociationTypeId(d.TypeId)}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q from %s to %s", ErrAssociationLabelNotFound, label, fromObjectType, toObjectType)
+}
@@ -0,0 +1,96 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHubSpotDefinedAssociationType(t *testing.T) {
+	tests := []struct {
+		from, to ObjectType
+		label    string
+		want     HubspotAssociationTypeId
+		ok       bool
+	}{
+		{ObjectTypeContacts, ObjectTypeCompanies, "", ContactToCompanyTypeId, true},
+		{ObjectTypeContacts, ObjectTypeCompanies, "Primary", ContactToCompanyPrimaryTypeId, true},
+		{ObjectTypeIdContacts, ObjectTypeIdCompanies, "primary", ContactToCompanyPrimaryTypeId, true},
+		{ObjectTypeCompanies, ObjectTypeCompanies, "Parent", CompanyToCompanyParentTypeId, true},
+		{ObjectTypeDeals, ObjectTypeLineItems, "", DealToLineItemTypeId, true},
+		{ObjectTypeContacts, ObjectTypeDeals, "Decision maker", 0, false},
+		{"2-1234567", ObjectTypeContacts, "", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := HubSpotDefinedAssociationType(tt.from, tt.to, tt.label)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("HubSpotDefinedAssociationType(%s, %s, %q) = %d, %v, want %d, %v", tt.from, tt.to, tt.label, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLookupTypeCatalogHit(t *testing.T) {
+	client, s := newRouteClient(t, nil)
+
+	got, err := client.Associations.LookupType(context.Background(), ObjectTypeIdDeals, ObjectTypeCompanies, "primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != (AssociationCreateOptions{Category: HubSpotDefined, TypeId: DealToCompanyPrimaryTypeId}) {
+		t.Errorf("type = %+v", got)
+	}
+	if len(s.requests) != 0 {
+		t.Errorf("a HubSpot defined type sent %d requests", len(s.requests))
+	}
+}
+
+func TestLookupTypeReadsDefinitions(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v4/associations/contacts/deals/labels": {Body: `{"results":[
+			{"category":"HUBSPOT_DEFINED","typeId":4,"label":null},
+			{"category":"USER_DEFINED","typeId":37,"label":"Decision maker"}
+		]}`},
+	})
+
+	got, err := client.Associations.LookupType(context.Background(), ObjectTypeContacts, ObjectTypeDeals, "decision MAKER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != (AssociationCreateOptions{Category: UserDefined, TypeId: 37}) {
+		t.Errorf("type = %+v", got)
+	}
+	if len(s.requests) != 1 {
+		t.Errorf("sent %d requests, want 1", len(s.requests))
+	}
+
+	_, err = client.Associations.LookupType(context.Background(), ObjectTypeContacts, ObjectTypeDeals, "Champion")
+	if !errors.Is(err, ErrAssociationLabelNotFound) {
+		t.Errorf("err = %v, want ErrAssociationLabelNotFound", err)
+	}
+}
+
+func TestLookupTypeCustomObjectDefault(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v4/associations/2-1234567/contacts/labels": {Body: `{"results":[{"category":"USER_DEFINED","typeId":12,"label":null}]}`},
+	})
+
+	got, err := client.Associations.LookupType(context.Background(), "2-1234567", ObjectTypeContacts, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != (AssociationCreateOptions{Category: UserDefined, TypeId: 12}) {
+		t.Errorf("type = %+v", got)
+	}
+	if s.last(t).Method != "GET" {
+		t.Errorf("method = %s", s.last(t).Method)
+	}
+}
+
+func TestLookupTypeDefinitionError(t *testing.T) {
+	client, _ := newRouteClient(t, nil)
+
+	_, err := client.Associations.LookupType(context.Background(), "2-1234567", ObjectTypeContacts, "Owner")
+	if !IsNotFound(err) {
+		t.Fatalf("err = %v, want the 404 of the definitions endpoint", err)
+	}
+}
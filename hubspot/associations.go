@@ -6,14 +6,23 @@ import (
 	"strconv"
 )
 
+// Associations reads and writes associations between CRM objects. Object types are passed as ObjectType,
+// by name or objectTypeId. Earlier versions took them as string: constants such as "contacts" still compile,
+// string variables have to be converted with ObjectType(objectType).
 type Associations interface {
-	List(ctx context.Context, fromObjectType string, fromObjectId int64, toObjectType string, query *AssociationListQuery) (*AssociationList, error)
-	Create(ctx context.Context, options *[]AssociationCreateOptions, fromObjectType string, fromObjectId int64, toObjectType string, toObjectId int64) (*AssociationCreateOutput, error)
-	Delete(ctx context.Context, fromObjectType string, fromObjectId int64, toObjectType string, toObjectId int64) error
-	ReadDefinition(ctx context.Context, fromObjectType string, toObjectType string) (*AssociationDefinitionOutput, error)
-	CreateDefinition(ctx context.Context, options *AssociationCreateDefinitionOptions, fromObjectType string, toObjectType string) (*AssociationDefinitionOutput, error)
-	UpdateDefinition(ctx context.Context, options *AssociationUpdateDefinitionOptions, fromObjectType string, toObjectType string) error
-	DeleteDefinition(ctx context.Context, fromObjectType string, toObjectType string, typeId int64) error
+	List(ctx context.Context, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, query *AssociationListQuery) (*AssociationList, error)
+	Create(ctx context.Context, options *[]AssociationCreateOptions, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, toObjectId int64) (*AssociationCreateOutput, error)
+	Delete(ctx context.Context, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, toObjectId int64) error
+	ReadDefinition(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationDefinitionOutput, error)
+	CreateDefinition(ctx context.Context, options *AssociationCreateDefinitionOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationDefinitionOutput, error)
+	UpdateDefinition(ctx context.Context, options *AssociationUpdateDefinitionOptions, fromObjectType ObjectType, toObjectType ObjectType) error
+	DeleteDefinition(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType, typeId int64) error
+	BatchCreate(ctx context.Context, options *AssociationBatchCreateOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchCreateOutput, error)
+	BatchRead(ctx context.Context, options *AssociationBatchReadOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchReadOutput, error)
+	BatchArchive(ctx context.Context, options *AssociationBatchArchiveOptions, fromObjectType ObjectType, toObjectType ObjectType) error
+	BatchArchiveLabels(ctx context.Context, options *AssociationBatchArchiveLabelsOptions, fromObjectType ObjectType, toObjectType ObjectType) error
+	BatchCreateDefault(ctx context.Context, options *AssociationBatchCreateDefaultOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchCreateDefaultOutput, error)
+	LookupType(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType, label string) (*AssociationCreateOptions, error)
 }
 
 type associations struct {
@@ -41,9 +50,6 @@ const (
 	HubSpotDefined    HubspotAssociationCategory = "HUBSPOT_DEFINED"
 	UserDefined       HubspotAssociationCategory = "USER_DEFINED"
 	IntegratorDefined HubspotAssociationCategory = "INTEGRATOR_DEFINED"
-
-	DealToContactTypeId HubspotAssociationTypeId = 3
-	LineItemToDealTypeId HubspotAssociationTypeId = 20
 )
 
 type AssociationType struct {
@@ -58,11 +64,11 @@ type AssociationCreateOptions struct {
 }
 
 type AssociationCreateOutput struct {
-	FromObjectTypeId string   `json:"fromObjectTypeId"`
-	FromObjectId     int64    `json:"fromObjectId"`
-	ToObjectTypeId   string   `json:"toObjectTypeId"`
-	ToObjectId       int64    `json:"toObjectId"`
-	Labels           []string `json:"labels,omitempty"`
+	FromObjectTypeId ObjectType `json:"fromObjectTypeId"`
+	FromObjectId     int64      `json:"fromObjectId"`
+	ToObjectTypeId   ObjectType `json:"toObjectTypeId"`
+	ToObjectId       int64      `json:"toObjectId"`
+	Labels           []string   `json:"labels,omitempty"`
 }
 
 type AssociationDefinitionOutput struct {
@@ -94,7 +100,110 @@ type AssociationTo struct {
 	Id string `json:"id"`
 }
 
-func (a *associations) List(ctx context.Context, fromObjectType string, fromObjectId int64, toObjectType string, query *AssociationListQuery) (*AssociationList, error) {
+type AssociationObjectId struct {
+	Id string `json:"id"`
+}
+
+// AssociationBatchLabelInput associates or dissociates a pair of objects with the given association types.
+type AssociationBatchLabelInput struct {
+	From  AssociationObjectId        `json:"from"`
+	To    AssociationObjectId        `json:"to"`
+	Types []AssociationCreateOptions `json:"types"`
+}
+
+type AssociationBatchCreateOptions struct {
+	Inputs []AssociationBatchLabelInput `json:"inputs"`
+}
+
+// AssociationBatchCreateOutput holds the created associations. Inputs which failed are reported in Errors.
+type AssociationBatchCreateOutput struct {
+	Status      string                    `json:"status"`
+	Results     []AssociationCreateOutput `json:"results"`
+	RequestedAt string                    `json:"requestedAt,omitempty"`
+	StartedAt   string                    `json:"startedAt"`
+	CompletedAt string                    `json:"completedAt"`
+	BatchErrors
+}
+
+type AssociationBatchReadOptions struct {
+	Inputs []AssociationBatchReadInput `json:"inputs"`
+}
+
+// AssociationBatchReadInput reads the associations of one object, starting at After.
+type AssociationBatchReadInput struct {
+	Id    string `json:"id"`
+	After string `json:"after,omitempty"`
+}
+
+// AssociationBatchReadOutput holds the associations of every input found. Inputs which failed are reported in Errors.
+type AssociationBatchReadOutput struct {
+	Status      string                       `json:"status"`
+	Results     []AssociationBatchReadResult `json:"results"`
+	RequestedAt string                       `json:"requestedAt,omitempty"`
+	StartedAt   string                       `json:"startedAt"`
+	CompletedAt string                       `json:"completedAt"`
+	BatchErrors
+}
+
+// AssociationBatchReadResult is one page of the associations of an object.
+type AssociationBatchReadResult struct {
+	From AssociationObjectId     `json:"from"`
+	To   []AssociationListResult `json:"to"`
+	Pagination
+}
+
+// NextInputs returns the inputs which read the following page of every object with more associations,
+// or nil once every object has been read completely.
+func (o *AssociationBatchReadOutput) NextInputs() []AssociationBatchReadInput {
+	var inputs []AssociationBatchReadInput
+	for _, r := range o.Results {
+		if after := r.NextAfter(); after != "" {
+			inputs = append(inputs, AssociationBatchReadInput{Id: r.From.Id, After: after})
+		}
+	}
+	return inputs
+}
+
+type AssociationBatchArchiveOptions struct {
+	Inputs []AssociationBatchArchiveInput `json:"inputs"`
+}
+
+// AssociationBatchArchiveInput removes every association between From and each of To.
+type AssociationBatchArchiveInput struct {
+	From AssociationObjectId   `json:"from"`
+	To   []AssociationObjectId `json:"to"`
+}
+
+type AssociationBatchArchiveLabelsOptions struct {
+	Inputs []AssociationBatchLabelInput `json:"inputs"`
+}
+
+type AssociationBatchCreateDefaultOptions struct {
+	Inputs []AssociationBatchDefaultInput `json:"inputs"`
+}
+
+type AssociationBatchDefaultInput struct {
+	From AssociationObjectId `json:"from"`
+	To   AssociationObjectId `json:"to"`
+}
+
+// AssociationBatchCreateDefaultOutput holds the created default associations. Inputs which failed are reported in Errors.
+type AssociationBatchCreateDefaultOutput struct {
+	Status      string                     `json:"status"`
+	Results     []AssociationDefaultResult `json:"results"`
+	RequestedAt string                     `json:"requestedAt,omitempty"`
+	StartedAt   string                     `json:"startedAt"`
+	CompletedAt string                     `json:"completedAt"`
+	BatchErrors
+}
+
+type AssociationDefaultResult struct {
+	From            AssociationObjectId      `json:"from"`
+	To              AssociationObjectId      `json:"to"`
+	AssociationSpec AssociationCreateOptions `json:"associationSpec"`
+}
+
+func (a *associations) List(ctx context.Context, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, query *AssociationListQuery) (*AssociationList, error) {
 	u := fmt.Sprintf("crm/v4/objects/%s/%s/associations/%s", fromObjectType, strconv.FormatInt(fromObjectId, 10), toObjectType)
 	req, err := a.client.newHttpRequest(ctx, "GET", u, query)
 	if err != nil {
@@ -110,7 +219,7 @@ func (a *associations) List(ctx context.Context, fromObjectType string, fromObje
 	return al, nil
 }
 
-func (a *associations) Create(ctx context.Context, options *[]AssociationCreateOptions, fromObjectType string, fromObjectId int64, toObjectType string, toObjectId int64) (*AssociationCreateOutput, error) {
+func (a *associations) Create(ctx context.Context, options *[]AssociationCreateOptions, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, toObjectId int64) (*AssociationCreateOutput, error) {
 	u := fmt.Sprintf("/crm/v4/objects/%s/%s/associations/%s/%s", fromObjectType, strconv.FormatInt(fromObjectId, 10), toObjectType, strconv.FormatInt(toObjectId, 10))
 	req, err := a.client.newHttpRequest(ctx, "PUT", u, options)
 	if err != nil {
@@ -126,7 +235,7 @@ func (a *associations) Create(ctx context.Context, options *[]AssociationCreateO
 	return aco, nil
 }
 
-func (a *associations) Delete(ctx context.Context, fromObjectType string, fromObjectId int64, toObjectType string, toObjectId int64) error {
+func (a *associations) Delete(ctx context.Context, fromObjectType ObjectType, fromObjectId int64, toObjectType ObjectType, toObjectId int64) error {
 	u := fmt.Sprintf("/crm/v4/objects/%s/%s/associations/%s/%s", fromObjectType, strconv.FormatInt(fromObjectId, 10), toObjectType, strconv.FormatInt(toObjectId, 10))
 	req, err := a.client.newHttpRequest(ctx, "DELETE", u, nil)
 	if err != nil {
@@ -135,7 +244,7 @@ func (a *associations) Delete(ctx context.Context, fromObjectType string, fromOb
 	return a.client.do(req, nil)
 }
 
-func (a *associations) ReadDefinition(ctx context.Context, fromObjectType string, toObjectType string) (*AssociationDefinitionOutput, error) {
+func (a *associations) ReadDefinition(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationDefinitionOutput, error) {
 	u := fmt.Sprintf("/crm/v4/associations/%s/%s/labels", fromObjectType, toObjectType)
 	req, err := a.client.newHttpRequest(ctx, "GET", u, nil)
 	if err != nil {
@@ -151,7 +260,7 @@ func (a *associations) ReadDefinition(ctx context.Context, fromObjectType string
 	return ard, nil
 }
 
-func (a *associations) CreateDefinition(ctx context.Context, options *AssociationCreateDefinitionOptions, fromObjectType string, toObjectType string) (*AssociationDefinitionOutput, error) {
+func (a *associations) CreateDefinition(ctx context.Context, options *AssociationCreateDefinitionOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationDefinitionOutput, error) {
 	u := fmt.Sprintf("/crm/v4/associations/%s/%s/labels", fromObjectType, toObjectType)
 	req, err := a.client.newHttpRequest(ctx, "POST", u, options)
 	if err != nil {
@@ -167,7 +276,7 @@ func (a *associations) CreateDefinition(ctx context.Context, options *Associatio
 	return ard, nil
 }
 
-func (a *associations) UpdateDefinition(ctx context.Context, options *AssociationUpdateDefinitionOptions, fromObjectType string, toObjectType string) error {
+func (a *associations) UpdateDefinition(ctx context.Context, options *AssociationUpdateDefinitionOptions, fromObjectType ObjectType, toObjectType ObjectType) error {
 	u := fmt.Sprintf("/crm/v4/associations/%s/%s/labels", fromObjectType, toObjectType)
 	req, err := a.client.newHttpRequest(ctx, "PUT", u, options)
 	if err != nil {
@@ -177,7 +286,7 @@ func (a *associations) UpdateDefinition(ctx context.Context, options *Associatio
 	return a.client.do(req, nil)
 }
 
-func (a *associations) DeleteDefinition(ctx context.Context, fromObjectType string, toObjectType string, typeId int64) error {
+func (a *associations) DeleteDefinition(ctx context.Context, fromObjectType ObjectType, toObjectType ObjectType, typeId int64) error {
 	u := fmt.Sprintf("/crm/v4/associations/%s/%s/labels/%s", fromObjectType, toObjectType, strconv.FormatInt(typeId, 10))
 	req, err := a.client.newHttpRequest(ctx, "DELETE", u, nil)
 	if err != nil {
@@ -185,3 +294,37 @@ func (a *associations) DeleteDefinition(ctx context.Context, fromObjectType stri
 	}
 	return a.client.do(req, nil)
 }
+
+func (a *associations) BatchCreate(ctx context.Context, options *AssociationBatchCreateOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchCreateOutput, error) {
+	u := fmt.Sprintf("/crm/v4/associations/%s/%s/batch/create", fromObjectType, toObjectType)
+	abo := &AssociationBatchCreateOutput{}
+
+	err := a.client.doBatch(ctx, u, options, abo)
+	return batchResult(abo, err)
+}
+
+func (a *associations) BatchRead(ctx context.Context, options *AssociationBatchReadOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchReadOutput, error) {
+	u := fmt.Sprintf("/crm/v4/associations/%s/%s/batch/read", fromObjectType, toObjectType)
+	abo := &AssociationBatchReadOutput{}
+
+	err := a.client.doBatch(ctx, u, options, abo)
+	return batchResult(abo, err)
+}
+
+func (a *associations) BatchArchive(ctx context.Context, options *AssociationBatchArchiveOptions, fromObjectType ObjectType, toObjectType ObjectType) error {
+	u := fmt.Sprintf("/crm/v4/associations/%s/%s/batch/archive", fromObjectType, toObjectType)
+	return a.client.doBatch(ctx, u, options, nil)
+}
+
+func (a *associations) BatchArchiveLabels(ctx context.Context, options *AssociationBatchArchiveLabelsOptions, fromObjectType ObjectType, toObjectType ObjectType) error {
+	u := fmt.Sprintf("/crm/v4/associations/%s/%s/batch/labels/archive", fromObjectType, toObjectType)
+	return a.client.doBatch(ctx, u, options, nil)
+}
+
+func (a *associations) BatchCreateDefault(ctx context.Context, options *AssociationBatchCreateDefaultOptions, fromObjectType ObjectType, toObjectType ObjectType) (*AssociationBatchCreateDefaultOutput, error) {
+	u := fmt.Sprintf("/crm/v4/associations/%s/%s/batch/associate/default", fromObjectType, toObjectType)
+	abo := &AssociationBatchCreateDefaultOutput{}
+
+	err := a.client.doBatch(ctx, u, options, abo)
+	return batchResult(abo, err)
+}
@@ -0,0 +1,174 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAssociationBatchCreate(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v4/associations/contacts/companies/batch/create": {Status: http.StatusCreated, Body: `{
+			"status": "COMPLETE",
+			"results": [{"fromObjectTypeId": "0-1", "fromObjectId": 1, "toObjectTypeId": "0-2", "toObjectId": 2, "labels": ["Primary"]}],
+			"startedAt": "2024-01-01T00:00:00Z",
+			"completedAt": "2024-01-01T00:00:01Z"
+		}`},
+	})
+
+	out, err := client.Associations.BatchCreate(context.Background(), &AssociationBatchCreateOptions{
+		Inputs: []AssociationBatchLabelInput{{
+			From:  AssociationObjectId{Id: "1"},
+			To:    AssociationObjectId{Id: "2"},
+			Types: []AssociationCreateOptions{{Category: HubSpotDefined, TypeId: 1}},
+		}},
+	}, ObjectTypeContacts, ObjectTypeCompanies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"inputs":[{"from":{"id":"1"},"to":{"id":"2"},"types":[{"associationCategory":"HUBSPOT_DEFINED","associationTypeId":1}]}]}`)
+	want := AssociationCreateOutput{FromObjectTypeId: "0-1", FromObjectId: 1, ToObjectTypeId: "0-2", ToObjectId: 2, Labels: []string{"Primary"}}
+	if len(out.Results) != 1 || !reflect.DeepEqual(out.Results[0], want) || out.HasErrors() {
+		t.Fatalf("output = %+v", out)
+	}
+}
+
+func TestAssociationBatchCreateMultiStatus(t *testing.T) {
+	client, _ := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v4/associations/contacts/companies/batch/create": {Status: http.StatusMultiStatus, Body: `{
+			"status": "COMPLETE",
+			"results": [{"fromObjectTypeId": "0-1", "fromObjectId": 1, "toObjectTypeId": "0-2", "toObjectId": 2}],
+			"numErrors": 1,
+			"errors": [{"status": "error", "category": "VALIDATION_ERROR", "message": "unknown object", "context": {"ids": ["3"]}}]
+		}`},
+	})
+
+	out, err := client.Associations.BatchCreate(context.Background(), &AssociationBatchCreateOptions{
+		Inputs: []AssociationBatchLabelInput{
+			{From: AssociationObjectId{Id: "1"}, To: AssociationObjectId{Id: "2"}},
+			{From: AssociationObjectId{Id: "3"}, To: AssociationObjectId{Id: "4"}},
+		},
+	}, ObjectTypeContacts, ObjectTypeCompanies)
+	if err != nil {
+		t.Fatalf("a 207 response failed the call: %v", err)
+	}
+	if !out.HasErrors() || !reflect.DeepEqual(out.FailedIds(), []string{"3"}) || len(out.Results) != 1 {
+		t.Fatalf("output = %+v", out)
+	}
+}
+
+func TestAssociationBatchArchive(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v4/associations/deals/contacts/batch/archive":        {Status: http.StatusNoContent},
+		"POST /crm/v4/associations/deals/contacts/batch/labels/archive": {Status: http.StatusNoContent},
+	})
+
+	err := client.Associations.BatchArchive(context.Background(), &AssociationBatchArchiveOptions{
+		Inputs: []AssociationBatchArchiveInput{{From: AssociationObjectId{Id: "1"}, To: []AssociationObjectId{{Id: "2"}, {Id: "3"}}}},
+	}, ObjectTypeDeals, ObjectTypeContacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"inputs":[{"from":{"id":"1"},"to":[{"id":"2"},{"id":"3"}]}]}`)
+
+	err = client.Associations.BatchArchiveLabels(context.Background(), &AssociationBatchArchiveLabelsOptions{
+		Inputs: []AssociationBatchLabelInput{{
+			From:  AssociationObjectId{Id: "1"},
+			To:    AssociationObjectId{Id: "2"},
+			Types: []AssociationCreateOptions{{Category: UserDefined, TypeId: 42}},
+		}},
+	}, ObjectTypeDeals, ObjectTypeContacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"inputs":[{"from":{"id":"1"},"to":{"id":"2"},"types":[{"associationCategory":"USER_DEFINED","associationTypeId":42}]}]}`)
+}
+
+func TestAssociationBatchCreateDefault(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v4/associations/contacts/companies/batch/associate/default": {Body: `{
+			"status": "COMPLETE",
+			"results": [{"from": {"id": "1"}, "to": {"id": "2"}, "associationSpec": {"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 279}}]
+		}`},
+	})
+
+	out, err := client.Associations.BatchCreateDefault(context.Background(), &AssociationBatchCreateDefaultOptions{
+		Inputs: []AssociationBatchDefaultInput{{From: AssociationObjectId{Id: "1"}, To: AssociationObjectId{Id: "2"}}},
+	}, ObjectTypeContacts, ObjectTypeCompanies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"inputs":[{"from":{"id":"1"},"to":{"id":"2"}}]}`)
+	if len(out.Results) != 1 || out.Results[0].AssociationSpec.TypeId != 279 {
+		t.Fatalf("output = %+v", out)
+	}
+}
+
+func TestAssociationBatchReadFailure(t *testing.T) {
+	client, _ := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v4/associations/contacts/companies/batch/read": {Status: http.StatusBadRequest, Body: `{"status":"error","category":"VALIDATION_ERROR","message":"invalid"}`},
+	})
+
+	out, err := client.Associations.BatchRead(context.Background(), &AssociationBatchReadOptions{
+		Inputs: []AssociationBatchReadInput{{Id: "1"}},
+	}, ObjectTypeContacts, ObjectTypeCompanies)
+	if out != nil || !IsValidationError(err) {
+		t.Fatalf("output %+v, err %v", out, err)
+	}
+}
+
+// associationPages serves the associations of contacts 1 and 2, contact 1 having a second page.
+func associationPages(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	switch string(body) {
+	case `{"inputs":[{"id":"1"},{"id":"2"}]}`:
+		w.Write([]byte(`{"status":"COMPLETE","results":[
+			{"from":{"id":"1"},"to":[{"toObjectId":10,"associationTypes":[{"category":"HUBSPOT_DEFINED","typeId":1}]}],"paging":{"next":{"after":"p2"}}},
+			{"from":{"id":"2"},"to":[{"toObjectId":20,"associationTypes":[]}]}
+		]}`))
+	case `{"inputs":[{"id":"1","after":"p2"}]}`:
+		w.Write([]byte(`{"status":"COMPLETE","results":[{"from":{"id":"1"},"to":[{"toObjectId":11,"associationTypes":[]}]}]}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(body))
+	}
+}
+
+func TestAssociationBatchReadNextInputs(t *testing.T) {
+	client := newTestClient(t, associationPages, WithRetryPolicy(nil))
+
+	options := &AssociationBatchReadOptions{Inputs: []AssociationBatchReadInput{{Id: "1"}, {Id: "2"}}}
+	toIds := map[string][]int64{}
+	for pages := 0; len(options.Inputs) > 0; pages++ {
+		if pages == 3 {
+			t.Fatal("NextInputs never ran out of pages")
+		}
+		out, err := client.Associations.BatchRead(context.Background(), options, ObjectTypeContacts, ObjectTypeCompanies)
+		if err != nil {
+			var errResponse *ErrorResponse
+			if errors.As(err, &errResponse) {
+				t.Fatalf("unexpected request %s", errResponse.Body)
+			}
+			t.Fatal(err)
+		}
+		for _, r := range out.Results {
+			for _, to := range r.To {
+				toIds[r.From.Id] = append(toIds[r.From.Id], to.ToObjectId)
+			}
+		}
+		options = &AssociationBatchReadOptions{Inputs: out.NextInputs()}
+	}
+	if want := map[string][]int64{"1": {10, 11}, "2": {20}}; !reflect.DeepEqual(toIds, want) {
+		t.Fatalf("associations = %v, want %v", toIds, want)
+	}
+}
+
+func TestAssociationBatchReadOutputNextInputs(t *testing.T) {
+	out := &AssociationBatchReadOutput{}
+	if inputs := out.NextInputs(); inputs != nil {
+		t.Fatalf("NextInputs of an empty output = %+v, want nil", inputs)
+	}
+}
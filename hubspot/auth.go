@@ -0,0 +1,81 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TokenSource supplies the bearer token sent in the Authorization header of every request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource for private app tokens which never expire.
+type StaticToken string
+
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// developerRequestKey marks requests authenticated with the developer API key instead of a bearer token.
+type developerRequestKey struct{}
+
+// noTokenSource is used by clients which only call the developer APIs.
+type noTokenSource struct{}
+
+func (noTokenSource) Token(ctx context.Context) (string, error) {
+	return "", fmt.Errorf(ErrMissingToken)
+}
+
+// NewDeveloperClient Creates a new HubSpot Client for the developer APIs of an app, e.g. Webhooks, which
+// authenticate with the developer API key of the app's developer account instead of a bearer token
+func NewDeveloperClient(developerAPIKey string, opts ...Option) (*Client, error) {
+	if developerAPIKey == "" {
+		return nil, fmt.Errorf(ErrMissingDeveloperAPIKey)
+	}
+	return NewClientWithTokenSource(noTokenSource{}, append(opts, WithDeveloperAPIKey(developerAPIKey))...)
+}
+
+// newDeveloperRequest creates a request authenticated with the developer API key, sent as the hapikey query parameter.
+func (c *Client) newDeveloperRequest(ctx context.Context, method string, endpoint string, v interface{}) (*http.Request, error) {
+	if c.developerAPIKey == "" {
+		return nil, fmt.Errorf(ErrMissingDeveloperAPIKey)
+	}
+	req, err := c.newHttpRequest(context.WithValue(ctx, developerRequestKey{}, true), method, endpoint, v)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Set("hapikey", c.developerAPIKey)
+	req.URL.RawQuery = q.Encode()
+	return req, nil
+}
+
+func isDeveloperRequest(req *http.Request) bool {
+	developer, _ := req.Context().Value(developerRequestKey{}).(bool)
+	return developer
+}
+
+// redactDeveloperAPIKey masks the hapikey query parameter in the URL of a transport error, so the developer
+// API key does not end up in logs.
+func redactDeveloperAPIKey(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		urlErr.URL = ""
+		return err
+	}
+	q := u.Query()
+	if q.Has("hapikey") {
+		q.Set("hapikey", "REDACTED")
+		u.RawQuery = q.Encode()
+		urlErr.URL = u.String()
+	}
+	return err
+}
@@ -0,0 +1,55 @@
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDeveloperRequestSendsAPIKey(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query().Get("hapikey")
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("developer request sent an Authorization header")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewDeveloperClient("secret-key", WithBaseURL(srv.URL), WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRateLimiter(nil)
+	if _, err := client.Webhooks.ReadSettings(context.Background(), "123"); err != nil {
+		t.Fatal(err)
+	}
+	if got != "secret-key" {
+		t.Errorf("hapikey = %q, want secret-key", got)
+	}
+}
+
+func TestDeveloperRequestRedactsAPIKeyFromErrors(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	client, err := NewDeveloperClient("secret-key", WithBaseURL(srv.URL), WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRateLimiter(nil)
+	_, err = client.Webhooks.ReadSettings(context.Background(), "123")
+	if err == nil {
+		t.Fatal("expected a transport error")
+	}
+	if strings.Contains(err.Error(), "secret-key") {
+		t.Errorf("error leaks the developer API key: %v", err)
+	}
+	if !strings.Contains(err.Error(), "hapikey=REDACTED") {
+		t.Errorf("error = %v, want the redacted hapikey", err)
+	}
+}
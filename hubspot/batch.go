@@ -0,0 +1,277 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const (
+	// MaxBatchInputs is the number of inputs HubSpot accepts in a single batch call. The Batch methods of
+	// every service split calls with more inputs into several requests, see doBatch and PartialBatchError.
+	MaxBatchInputs = 100
+
+	// DefaultBatchConcurrency is the number of requests of a split batch call which run at the same time.
+	DefaultBatchConcurrency = 4
+)
+
+// FailedBatch is a request of a split batch call which failed. Indexes holds the position of each of
+// its inputs in the original call and Ids their ids, for inputs which have one.
+type FailedBatch struct {
+	Indexes []int
+	Ids     []string
+	Err     error
+}
+
+// ErrInvalidBatchOptions is returned by the Batch methods, without sending a request, when options is nil
+// or its Inputs slice is missing or empty.
+var ErrInvalidBatchOptions = errors.New("hubspot: batch options must be a struct with a non-empty Inputs slice")
+
+// PartialBatchError is returned by a batch call split into several requests when some of them failed.
+//
+// Every Batch method fails the same way whatever the number of inputs:
+//   - when no request succeeded, the output is nil. A call of up to MaxBatchInputs inputs returns the error
+//     of its request, a split call returns a *PartialBatchError with Succeeded set to 0.
+//   - when some requests succeeded, the output holds their results and the error is a *PartialBatchError
+//     describing the inputs of the failed requests.
+//
+// A *PartialBatchError unwraps to the errors of its requests, so errors.As with an *ErrorResponse works
+// for both small and split calls.
+type PartialBatchError struct {
+	Failed    []FailedBatch
+	Succeeded int
+}
+
+func (e *PartialBatchError) Error() string {
+	msgs := make([]string, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		msgs = append(msgs, fmt.Sprintf("inputs %d-%d: %v", f.Indexes[0], f.Indexes[len(f.Indexes)-1], f.Err))
+	}
+	return fmt.Sprintf("hubspot: %d of %d batch requests failed: %s", len(e.Failed), len(e.Failed)+e.Succeeded, strings.Join(msgs, "; "))
+}
+
+// Unwrap allows the errors of the failed requests to be matched with errors.Is and errors.As.
+func (e *PartialBatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
+
+// FailedIds returns the ids of every input which was part of a failed request.
+func (e *PartialBatchError) FailedIds() []string {
+	var ids []string
+	for _, f := range e.Failed {
+		ids = append(ids, f.Ids...)
+	}
+	return ids
+}
+
+// FailedIndexes returns the position of every input which was part of a failed request.
+func (e *PartialBatchError) FailedIndexes() []int {
+	var indexes []int
+	for _, f := range e.Failed {
+		indexes = append(indexes, f.Indexes...)
+	}
+	return indexes
+}
+
+// SetBatchConcurrency sets the number of requests of a split batch call which run at the same time.
+func (c *Client) SetBatchConcurrency(n int) {
+	c.batchConcurrency = n
+}
+
+// doBatch posts options, a struct with an Inputs slice, to the batch endpoint u and decodes the response into v.
+// Every Batch method goes through it: more than MaxBatchInputs inputs are sent in several requests, at most
+// batchConcurrency at a time, whose outputs are merged into v. See PartialBatchError for how failures are reported.
+func (c *Client) doBatch(ctx context.Context, u string, options interface{}, v interface{}) error {
+	opts := reflect.Indirect(reflect.ValueOf(options))
+	if opts.Kind() != reflect.Struct {
+		return ErrInvalidBatchOptions
+	}
+	inputs := opts.FieldByName("Inputs")
+	if inputs.Kind() != reflect.Slice || inputs.Len() == 0 {
+		return ErrInvalidBatchOptions
+	}
+	if inputs.Len() <= MaxBatchInputs {
+		req, err := c.newHttpRequest(ctx, "POST", u, options)
+		if err != nil {
+			return err
+		}
+		return c.do(req, v)
+	}
+
+	chunks := (inputs.Len() + MaxBatchInputs - 1) / MaxBatchInputs
+	outputs := make([]interface{}, chunks)
+	errs := make([]error, chunks)
+	concurrency := c.batchConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < chunks; i++ {
+		// Copy the options so every request keeps the other fields, e.g. Properties or IdProperty
+		chunk := reflect.New(opts.Type())
+		chunk.Elem().Set(opts)
+		chunk.Elem().FieldByName("Inputs").Set(inputs.Slice(i*MaxBatchInputs, min((i+1)*MaxBatchInputs, inputs.Len())))
+
+		wg.Add(1)
+		go func(i int, chunk interface{}) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			req, err := c.newHttpRequest(ctx, "POST", u, chunk)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			if v != nil {
+				outputs[i] = reflect.New(reflect.TypeOf(v).Elem()).Interface()
+			}
+			errs[i] = c.do(req, outputs[i])
+		}(i, chunk.Interface())
+	}
+	wg.Wait()
+
+	partial := &PartialBatchError{}
+	merged := false
+	for i, err := range errs {
+		if err != nil {
+			partial.Failed = append(partial.Failed, failedBatch(inputs, i*MaxBatchInputs, min((i+1)*MaxBatchInputs, inputs.Len()), err))
+			continue
+		}
+		partial.Succeeded++
+		if v == nil {
+			continue
+		}
+		if !merged {
+			reflect.ValueOf(v).Elem().Set(reflect.ValueOf(outputs[i]).Elem())
+			merged = true
+			continue
+		}
+		mergeBatchOutput(reflect.ValueOf(v).Elem(), reflect.ValueOf(outputs[i]).Elem())
+	}
+	if len(partial.Failed) > 0 {
+		return partial
+	}
+	return nil
+}
+
+// failedBatch describes the inputs between from and to, using their Id field when they have one.
+func failedBatch(inputs reflect.Value, from int, to int, err error) FailedBatch {
+	f := FailedBatch{Err: err}
+	for i := from; i < to; i++ {
+		f.Indexes = append(f.Indexes, i)
+		input := reflect.Indirect(inputs.Index(i))
+		if input.Kind() != reflect.Struct {
+			continue
+		}
+		if id := input.FieldByName("Id"); id.IsValid() && id.Kind() == reflect.String {
+			f.Ids = append(f.Ids, id.String())
+		}
+	}
+	return f
+}
+
+// mergeBatchOutput appends the results of src to dst. Counts are summed and the latest CompletedAt is kept.
+func mergeBatchOutput(dst reflect.Value, src reflect.Value) {
+	for i := 0; i < dst.NumField(); i++ {
+		d, s := dst.Field(i), src.Field(i)
+		switch {
+		case d.Kind() == reflect.Slice:
+			d.Set(reflect.AppendSlice(d, s))
+		case d.Kind() == reflect.Int:
+			d.SetInt(d.Int() + s.Int())
+		case d.Kind() == reflect.Struct && dst.Type().Field(i).Anonymous:
+			mergeBatchOutput(d, s)
+		case dst.Type().Field(i).Name == "CompletedAt" && s.String() > d.String():
+			d.Set(s)
+		}
+	}
+}
+
+// batchResult returns v together with a *PartialBatchError when some requests succeeded, and drops it
+// for any other error.
+func batchResult[T any](v *T, err error) (*T, error) {
+	partial := &PartialBatchError{}
+	if err != nil && (!errors.As(err, &partial) || partial.Succeeded == 0) {
+		return nil, err
+	}
+	return v, err
+}
+
+// ErrRetryWithoutIds is returned by RetryInputs for batch options whose inputs have no Id, such as the
+// options of BatchCreate. Resubmit those by position with RetryIndexes instead.
+var ErrRetryWithoutIds = errors.New("hubspot: batch inputs have no Id to match failed inputs on, use RetryIndexes")
+
+// RetryInputs returns a copy of options, a batch options struct such as *ContactBatchUpdateOptions, whose
+// Inputs only holds the inputs with one of the given ids. Use it to resubmit the FailedIds of a batch
+// output or of a *PartialBatchError.
+//
+// Inputs are matched on their Id field. For upserts that is the IdProperty value, which is also what
+// HubSpot reports in the errors of an upsert. Create inputs have no Id and return ErrRetryWithoutIds.
+func RetryInputs[O any](options *O, ids []string) (*O, error) {
+	return filterInputs(options, func(i int, input reflect.Value) (bool, error) {
+		id := input.FieldByName("Id")
+		if !id.IsValid() || id.Kind() != reflect.String {
+			return false, ErrRetryWithoutIds
+		}
+		return containsString(ids, id.String()), nil
+	})
+}
+
+// RetryIndexes returns a copy of options whose Inputs only holds the inputs at the given positions, e.g.
+// the FailedIndexes of a *PartialBatchError. It works for every batch options struct, including creates.
+// The per-input errors in the BatchErrors of a create cannot be mapped back to a position, as HubSpot
+// reports neither an id nor an index for them.
+func RetryIndexes[O any](options *O, indexes []int) (*O, error) {
+	failed := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		failed[i] = true
+	}
+	return filterInputs(options, func(i int, _ reflect.Value) (bool, error) {
+		return failed[i], nil
+	})
+}
+
+// filterInputs returns a copy of options whose Inputs only holds the inputs for which keep returns true.
+func filterInputs[O any](options *O, keep func(i int, input reflect.Value) (bool, error)) (*O, error) {
+	if options == nil {
+		return nil, ErrInvalidBatchOptions
+	}
+	retry := *options
+	opts := reflect.ValueOf(&retry).Elem()
+	if opts.Kind() != reflect.Struct {
+		return nil, ErrInvalidBatchOptions
+	}
+	inputs := opts.FieldByName("Inputs")
+	if inputs.Kind() != reflect.Slice {
+		return nil, ErrInvalidBatchOptions
+	}
+	kept := reflect.MakeSlice(inputs.Type(), 0, 0)
+	for i := 0; i < inputs.Len(); i++ {
+		input := reflect.Indirect(inputs.Index(i))
+		if input.Kind() != reflect.Struct {
+			return nil, ErrInvalidBatchOptions
+		}
+		ok, err := keep(i, input)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			kept = reflect.Append(kept, inputs.Index(i))
+		}
+	}
+	inputs.Set(kept)
+	return &retry, nil
+}
@@ -0,0 +1,303 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// batchServer echoes the ids of every batch request as results, failing the requests whose first input
+// id is in fail. It records the number of inputs of each request and the peak number of concurrent requests.
+type batchServer struct {
+	fail     map[string]bool
+	delay    time.Duration
+	mu       sync.Mutex
+	sizes    []int
+	inFlight int32
+	peak     int32
+}
+
+func (s *batchServer) handle(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt32(&s.inFlight, 1)
+	defer atomic.AddInt32(&s.inFlight, -1)
+	for {
+		peak := atomic.LoadInt32(&s.peak)
+		if n <= peak || atomic.CompareAndSwapInt32(&s.peak, peak, n) {
+			break
+		}
+	}
+	time.Sleep(s.delay)
+
+	var options struct {
+		Inputs []struct {
+			Id string `json:"id"`
+		} `json:"inputs"`
+	}
+	json.NewDecoder(r.Body).Decode(&options)
+	s.mu.Lock()
+	s.sizes = append(s.sizes, len(options.Inputs))
+	request := len(s.sizes)
+	s.mu.Unlock()
+
+	if len(options.Inputs) > MaxBatchInputs {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(options.Inputs) > 0 && s.fail[options.Inputs[0].Id] {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"error","message":"invalid input","category":"VALIDATION_ERROR"}`))
+		return
+	}
+	results := make([]Contact, 0, len(options.Inputs))
+	for _, input := range options.Inputs {
+		results = append(results, Contact{Id: input.Id})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":      "COMPLETE",
+		"results":     results,
+		"completedAt": "2024-01-01T00:00:" + fmt.Sprintf("%02d", request) + "Z",
+		"numErrors":   1,
+		"errors":      []BatchError{{Status: "error", Message: "warning " + options.Inputs[0].Id}},
+	})
+}
+
+func contactUpdates(n int) *ContactBatchUpdateOptions {
+	options := &ContactBatchUpdateOptions{}
+	for i := 0; i < n; i++ {
+		options.Inputs = append(options.Inputs, ContactBatchUpdateProperties{Id: strconv.Itoa(i)})
+	}
+	return options
+}
+
+func newBatchClient(t *testing.T, s *batchServer) *Client {
+	client := newTestClient(t, s.handle)
+	client.SetRetryPolicy(nil)
+	return client
+}
+
+func TestBatchSplitsAndMerges(t *testing.T) {
+	s := &batchServer{}
+	client := newBatchClient(t, s)
+
+	output, err := client.Contacts.BatchUpdate(context.Background(), contactUpdates(250))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.sizes) != 3 {
+		t.Fatalf("request sizes = %v, want 3 requests", s.sizes)
+	}
+	total := 0
+	for _, size := range s.sizes {
+		total += size
+	}
+	if total != 250 {
+		t.Fatalf("request sizes = %v", s.sizes)
+	}
+	if len(output.Results) != 250 {
+		t.Fatalf("%d merged results, want 250", len(output.Results))
+	}
+	seen := make(map[string]bool)
+	for _, c := range output.Results {
+		seen[c.Id] = true
+	}
+	if len(seen) != 250 {
+		t.Fatalf("%d distinct results, want 250", len(seen))
+	}
+	if output.NumErrors != 3 || len(output.Errors) != 3 {
+		t.Fatalf("merged %d errors with NumErrors %d, want 3", len(output.Errors), output.NumErrors)
+	}
+	if output.CompletedAt != "2024-01-01T00:00:03Z" {
+		t.Fatalf("CompletedAt = %s, want the latest", output.CompletedAt)
+	}
+}
+
+func TestBatchUpToMaxInputsIsSingleRequest(t *testing.T) {
+	s := &batchServer{}
+	client := newBatchClient(t, s)
+
+	if _, err := client.Contacts.BatchUpdate(context.Background(), contactUpdates(MaxBatchInputs)); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.sizes) != 1 || s.sizes[0] != MaxBatchInputs {
+		t.Fatalf("request sizes = %v", s.sizes)
+	}
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	for _, concurrency := range []int{1, 2, 3} {
+		s := &batchServer{delay: 20 * time.Millisecond}
+		client := newBatchClient(t, s)
+		client.SetBatchConcurrency(concurrency)
+
+		if _, err := client.Contacts.BatchUpdate(context.Background(), contactUpdates(1000)); err != nil {
+			t.Fatal(err)
+		}
+		if s.peak > int32(concurrency) {
+			t.Fatalf("concurrency %d: peak of %d requests in flight", concurrency, s.peak)
+		}
+		if concurrency > 1 && s.peak < 2 {
+			t.Fatalf("concurrency %d: requests never overlapped", concurrency)
+		}
+	}
+}
+
+func TestBatchPartialFailure(t *testing.T) {
+	s := &batchServer{fail: map[string]bool{"100": true}}
+	client := newBatchClient(t, s)
+
+	output, err := client.Contacts.BatchUpdate(context.Background(), contactUpdates(250))
+	partial := &PartialBatchError{}
+	if !errors.As(err, &partial) {
+		t.Fatalf("err = %v, want a *PartialBatchError", err)
+	}
+	if output == nil || len(output.Results) != 150 {
+		t.Fatalf("output = %+v, want the 150 results of the requests which succeeded", output)
+	}
+	if partial.Succeeded != 2 || len(partial.Failed) != 1 {
+		t.Fatalf("partial = %+v", partial)
+	}
+	indexes := partial.FailedIndexes()
+	ids := partial.FailedIds()
+	if len(indexes) != 100 || indexes[0] != 100 || indexes[99] != 199 || len(ids) != 100 || ids[0] != "100" {
+		t.Fatalf("failed indexes %v..., ids %v...", indexes[:1], ids[:1])
+	}
+	errResponse := &ErrorResponse{}
+	if !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusBadRequest {
+		t.Fatalf("err = %v, want it to unwrap to a 400 *ErrorResponse", err)
+	}
+
+	retry, err := RetryInputs(contactUpdates(250), ids)
+	if err != nil || len(retry.Inputs) != 100 || retry.Inputs[0].Id != "100" {
+		t.Fatalf("retry inputs = %+v, %v", retry, err)
+	}
+}
+
+func TestRetryInputsMatchesIds(t *testing.T) {
+	options := contactUpdates(5)
+	retry, err := RetryInputs(options, []string{"1", "3", "missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retry.Inputs) != 2 || retry.Inputs[0].Id != "1" || retry.Inputs[1].Id != "3" {
+		t.Fatalf("retry inputs = %+v", retry.Inputs)
+	}
+	if len(options.Inputs) != 5 {
+		t.Fatal("RetryInputs modified the original options")
+	}
+
+	upsert := &ContactBatchUpsertOptions{IdProperty: "email", Inputs: []ContactBatchUpdateProperties{{Id: "a@example.com"}, {Id: "b@example.com"}}}
+	retryUpsert, err := RetryInputs(upsert, BatchErrors{Errors: []BatchError{{Context: map[string][]string{"ids": {"b@example.com"}}}}}.FailedIds())
+	if err != nil || len(retryUpsert.Inputs) != 1 || retryUpsert.Inputs[0].Id != "b@example.com" || retryUpsert.IdProperty != "email" {
+		t.Fatalf("retry upsert = %+v, %v", retryUpsert, err)
+	}
+}
+
+func TestRetryInputsRejectsInputsWithoutIds(t *testing.T) {
+	create := &ContactBatchCreateOptions{Inputs: []ContactCreateOrUpdateOptions{{}, {}}}
+	if _, err := RetryInputs(create, []string{"1"}); !errors.Is(err, ErrRetryWithoutIds) {
+		t.Fatalf("err = %v, want ErrRetryWithoutIds", err)
+	}
+	var nilOptions *ContactBatchUpdateOptions
+	if _, err := RetryInputs(nilOptions, []string{"1"}); !errors.Is(err, ErrInvalidBatchOptions) {
+		t.Fatalf("err = %v, want ErrInvalidBatchOptions", err)
+	}
+}
+
+func TestRetryIndexesResubmitsFailedCreates(t *testing.T) {
+	s := &batchServer{fail: map[string]bool{"": true}}
+	client := newBatchClient(t, s)
+
+	create := &ContactBatchCreateOptions{}
+	for i := 0; i < 150; i++ {
+		create.Inputs = append(create.Inputs, ContactCreateOrUpdateOptions{Properties: ContactProperties{Email: strconv.Itoa(i) + "@example.com"}})
+	}
+	_, err := client.Contacts.BatchCreate(context.Background(), create)
+	partial := &PartialBatchError{}
+	if !errors.As(err, &partial) {
+		t.Fatalf("err = %v, want a *PartialBatchError", err)
+	}
+
+	retry, err := RetryIndexes(create, partial.FailedIndexes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retry.Inputs) != 150 || retry.Inputs[149].Properties.Email != "149@example.com" {
+		t.Fatalf("retry inputs = %d", len(retry.Inputs))
+	}
+
+	retry, err = RetryIndexes(create, []int{0, 120})
+	if err != nil || len(retry.Inputs) != 2 || retry.Inputs[1].Properties.Email != "120@example.com" {
+		t.Fatalf("retry = %+v, %v", retry, err)
+	}
+}
+
+func TestBatchFailureShapeIsIndependentOfSize(t *testing.T) {
+	for _, n := range []int{10, 250} {
+		fail := map[string]bool{}
+		for i := 0; i < n; i += MaxBatchInputs {
+			fail[strconv.Itoa(i)] = true
+		}
+		client := newBatchClient(t, &batchServer{fail: fail})
+
+		output, err := client.Contacts.BatchUpdate(context.Background(), contactUpdates(n))
+		if output != nil {
+			t.Fatalf("%d inputs: output %+v returned although every request failed", n, output)
+		}
+		errResponse := &ErrorResponse{}
+		if !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusBadRequest {
+			t.Fatalf("%d inputs: err = %v, want a 400 *ErrorResponse", n, err)
+		}
+	}
+}
+
+func TestBatchInvalidOptions(t *testing.T) {
+	s := &batchServer{}
+	client := newBatchClient(t, s)
+
+	if _, err := client.Contacts.BatchUpdate(context.Background(), nil); !errors.Is(err, ErrInvalidBatchOptions) {
+		t.Fatalf("nil options: err = %v", err)
+	}
+	if err := client.doBatch(context.Background(), "/crm/v3/objects/contacts/batch/update", struct{ Ids []string }{}, nil); !errors.Is(err, ErrInvalidBatchOptions) {
+		t.Fatalf("options without Inputs: err = %v", err)
+	}
+	if _, err := client.Contacts.BatchUpdate(context.Background(), &ContactBatchUpdateOptions{}); !errors.Is(err, ErrInvalidBatchOptions) {
+		t.Fatalf("empty Inputs: err = %v", err)
+	}
+	if err := client.Contacts.BatchArchive(context.Background(), []string{}); !errors.Is(err, ErrInvalidBatchOptions) {
+		t.Fatalf("no ids: err = %v", err)
+	}
+	if len(s.sizes) != 0 {
+		t.Fatalf("%d requests sent", len(s.sizes))
+	}
+}
+
+func TestAssociationBatchIsSplit(t *testing.T) {
+	s := &batchServer{}
+	client := newBatchClient(t, s)
+
+	options := &AssociationBatchReadOptions{}
+	for i := 0; i < 150; i++ {
+		options.Inputs = append(options.Inputs, AssociationBatchReadInput{Id: strconv.Itoa(i)})
+	}
+	if _, err := client.Associations.BatchRead(context.Background(), options, ObjectTypeContacts, ObjectTypeCompanies); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := &AssociationBatchArchiveOptions{}
+	for i := 0; i < 150; i++ {
+		archive.Inputs = append(archive.Inputs, AssociationBatchArchiveInput{From: AssociationObjectId{Id: strconv.Itoa(i)}})
+	}
+	if err := client.Associations.BatchArchive(context.Background(), archive, ObjectTypeContacts, ObjectTypeCompanies); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.sizes) != 4 {
+		t.Fatalf("request sizes = %v, want 4 requests", s.sizes)
+	}
+}
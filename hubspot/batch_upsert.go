@@ -0,0 +1,16 @@
+package hubspot
+
+// upsertInputs returns a copy of inputs in which every input without an idProperty of its own uses idProperty.
+func upsertInputs[T any](inputs []T, idProperty string, field func(*T) *string) []T {
+	if idProperty == "" {
+		return inputs
+	}
+	out := make([]T, len(inputs))
+	for i, input := range inputs {
+		if p := field(&input); *p == "" {
+			*p = idProperty
+		}
+		out[i] = input
+	}
+	return out
+}
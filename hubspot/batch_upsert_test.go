@@ -0,0 +1,120 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// upsertServer answers batch upserts with one result per input, the inputs with an even id being new.
+// It records the idProperty of every input it receives.
+type upsertServer struct {
+	mu          sync.Mutex
+	idProperty  map[string]string
+	requestSize []int
+}
+
+func (s *upsertServer) handle(w http.ResponseWriter, r *http.Request) {
+	var options struct {
+		Inputs []struct {
+			Id         string          `json:"id"`
+			IdProperty *string         `json:"idProperty"`
+			Properties json.RawMessage `json:"properties"`
+		} `json:"inputs"`
+	}
+	json.NewDecoder(r.Body).Decode(&options)
+
+	results := make([]map[string]interface{}, 0, len(options.Inputs))
+	s.mu.Lock()
+	s.requestSize = append(s.requestSize, len(options.Inputs))
+	for _, input := range options.Inputs {
+		idProperty := "<missing>"
+		if input.IdProperty != nil {
+			idProperty = *input.IdProperty
+		}
+		s.idProperty[input.Id] = idProperty
+		n, _ := strconv.Atoi(input.Id)
+		results = append(results, map[string]interface{}{
+			"id":         "hs-" + input.Id,
+			"properties": input.Properties,
+			"new":        n%2 == 0,
+		})
+	}
+	s.mu.Unlock()
+	json.NewEncoder(w).Encode(map[string]interface{}{"status": "COMPLETE", "results": results})
+}
+
+func newUpsertClient(t *testing.T) (*Client, *upsertServer) {
+	s := &upsertServer{idProperty: map[string]string{}}
+	return newTestClient(t, s.handle, WithRetryPolicy(nil)), s
+}
+
+func TestBatchUpsertSetsIdProperty(t *testing.T) {
+	client, s := newUpsertClient(t)
+
+	out, err := client.Contacts.BatchUpsert(context.Background(), &ContactBatchUpsertOptions{
+		IdProperty: "email",
+		Inputs: []ContactBatchUpdateProperties{
+			{Id: "0", Properties: ContactProperties{Email: "a@example.com"}},
+			{Id: "1", IdProperty: "external_id", Properties: ContactProperties{Email: "b@example.com"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"0": "email", "1": "external_id"}; !reflect.DeepEqual(s.idProperty, want) {
+		t.Fatalf("idProperty per input = %v, want %v", s.idProperty, want)
+	}
+	if len(out.Results) != 2 {
+		t.Fatalf("results = %+v", out.Results)
+	}
+	if !out.Results[0].New || out.Results[1].New {
+		t.Errorf("new = %v, %v, want true, false", out.Results[0].New, out.Results[1].New)
+	}
+	if out.Results[0].Id != "hs-0" || out.Results[1].Properties.Email != "b@example.com" {
+		t.Errorf("results = %+v", out.Results)
+	}
+}
+
+func TestBatchUpsertWithoutIdProperty(t *testing.T) {
+	client, s := newUpsertClient(t)
+
+	options := &DealBatchUpsertOptions{Inputs: []DealBatchUpdateProperties{{Id: "0", IdProperty: "deal_ref"}, {Id: "1"}}}
+	if _, err := client.Deals.BatchUpsert(context.Background(), options); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"0": "deal_ref", "1": "<missing>"}; !reflect.DeepEqual(s.idProperty, want) {
+		t.Fatalf("idProperty per input = %v, want %v", s.idProperty, want)
+	}
+}
+
+func TestBatchUpsertSplitKeepsIdProperty(t *testing.T) {
+	client, s := newUpsertClient(t)
+
+	options := &ObjectBatchUpsertOptions{IdProperty: "external_id"}
+	for i := 0; i < MaxBatchInputs+1; i++ {
+		options.Inputs = append(options.Inputs, ObjectBatchUpdateProperties{Id: strconv.Itoa(i), Properties: RawProperties{"name": strconv.Itoa(i)}})
+	}
+	out, err := client.Objects.BatchUpsert(context.Background(), "2-1234567", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.requestSize) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(s.requestSize))
+	}
+	for id, idProperty := range s.idProperty {
+		if idProperty != "external_id" {
+			t.Fatalf("input %s sent with idProperty %q", id, idProperty)
+		}
+	}
+	if len(out.Results) != MaxBatchInputs+1 || !out.Results[0].New || out.Results[1].New || out.Results[1].Properties.Get("name") != "1" {
+		t.Fatalf("got %d results, first %+v", len(out.Results), out.Results[:2])
+	}
+	if options.Inputs[0].IdProperty != "" {
+		t.Errorf("BatchUpsert modified the inputs of the caller")
+	}
+}
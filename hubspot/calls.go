@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Calls interface {
 	BatchCreate(ctx context.Context, options *CallBatchCreateOptions) (*CallBatchOutput, error)
 	BatchRead(ctx context.Context, options *CallBatchReadOptions) (*CallBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *CallBatchUpdateOptions) (*CallBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *CallBatchUpsertOptions) (*CallBatchUpsertOutput, error)
 	Search(ctx context.Context, options *CallSearchOptions) (*CallSearchResults, error)
 	Merge(ctx context.Context, options *CallMergeOptions) (*Call, error)
 }
@@ -50,11 +52,13 @@ type CallList struct {
 }
 
 type Call struct {
-	Id         string         `json:"id"`
-	Properties CallProperties `json:"properties"`
-	CreatedAt  string         `json:"createdAt"`
-	UpdatedAt  string         `json:"updatedAt"`
-	Archived   bool           `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            CallProperties     `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type CallProperties struct {
@@ -68,6 +72,35 @@ type CallProperties struct {
 	HsCallToNumber     string `json:"hs_call_to_number,omitempty"`
 	HsTimestamp        string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId     string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p CallProperties) MarshalJSON() ([]byte, error) {
+	type properties CallProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *CallProperties) UnmarshalJSON(b []byte) error {
+	type properties CallProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+}
+
+// CreateOrUpdateProperties returns the writable properties of p, including its custom properties in Raw,
+// so that a read call can be modified and passed to Update without losing them.
+func (p CallProperties) CreateOrUpdateProperties() CallCreateOrUpdateProperties {
+	return CallCreateOrUpdateProperties{
+		HsCallBody:         p.HsCallBody,
+		HsCallDuration:     p.HsCallDuration,
+		HsCallFromNumber:   p.HsCallFromNumber,
+		HsCallRecordingUrl: p.HsCallRecordingUrl,
+		HsCallStatus:       p.HsCallStatus,
+		HsCallTitle:        p.HsCallTitle,
+		HsCallToNumber:     p.HsCallToNumber,
+		HsTimestamp:        p.HsTimestamp,
+		HubSpotOwnerId:     p.HubSpotOwnerId,
+		Raw:                writableRawProperties(p.Raw),
+	}
 }
 
 type CallCreateOrUpdateOptions struct {
@@ -84,6 +117,18 @@ type CallCreateOrUpdateProperties struct {
 	HsCallToNumber     string `json:"hs_call_to_number,omitempty"`
 	HsTimestamp        string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId     string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p CallCreateOrUpdateProperties) MarshalJSON() ([]byte, error) {
+	type properties CallCreateOrUpdateProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *CallCreateOrUpdateProperties) UnmarshalJSON(b []byte) error {
+	type properties CallCreateOrUpdateProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type CallReadQuery struct {
@@ -100,6 +145,7 @@ type CallBatchOutput struct {
 	RequestedAt string `json:"requestedAt"`
 	StartedAt   string `json:"startedAt"`
 	CompletedAt string `json:"completedAt"`
+	BatchErrors
 }
 
 type CallBatchReadOptions struct {
@@ -116,9 +162,38 @@ type CallBatchUpdateOptions struct {
 
 type CallBatchUpdateProperties struct {
 	Id         string                       `json:"id"`
+	IdProperty string                       `json:"idProperty,omitempty"`
 	Properties CallCreateOrUpdateProperties `json:"properties"`
 }
 
+// CallBatchUpsertOptions creates or updates the calls whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type CallBatchUpsertOptions struct {
+	IdProperty string                      `json:"-"`
+	Inputs     []CallBatchUpdateProperties `json:"inputs"`
+}
+
+func (o CallBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options CallBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *CallBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type CallBatchUpsertOutput struct {
+	Status      string             `json:"status"`
+	Results     []CallUpsertResult `json:"results"`
+	RequestedAt string             `json:"requestedAt"`
+	StartedAt   string             `json:"startedAt"`
+	CompletedAt string             `json:"completedAt"`
+	BatchErrors
+}
+
+// CallUpsertResult is an upserted call. New is true when it was created and false when it was updated.
+type CallUpsertResult struct {
+	Call
+	New bool `json:"new"`
+}
+
 type CallSearchOptions struct {
 	SearchOptions
 }
@@ -256,59 +331,39 @@ func (z *calls) BatchArchive(ctx context.Context, callIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: callId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *calls) BatchCreate(ctx context.Context, options *CallBatchCreateOptions) (*CallBatchOutput, error) {
 	u := "/crm/v3/objects/calls/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	calls := &CallBatchOutput{}
 
-	err = z.client.do(req, calls)
-	if err != nil {
-		return nil, err
-	}
-	return calls, nil
+	err := z.client.doBatch(ctx, u, options, calls)
+	return batchResult(calls, err)
 }
 
 func (z *calls) BatchRead(ctx context.Context, options *CallBatchReadOptions) (*CallBatchOutput, error) {
 	u := "/crm/v3/objects/calls/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	calls := &CallBatchOutput{}
 
-	err = z.client.do(req, calls)
-	if err != nil {
-		return nil, err
-	}
-	return calls, nil
+	err := z.client.doBatch(ctx, u, options, calls)
+	return batchResult(calls, err)
 }
 
 func (z *calls) BatchUpdate(ctx context.Context, options *CallBatchUpdateOptions) (*CallBatchOutput, error) {
 	u := "/crm/v3/objects/calls/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	calls := &CallBatchOutput{}
 
-	err = z.client.do(req, calls)
-	if err != nil {
-		return nil, err
-	}
-	return calls, nil
+	err := z.client.doBatch(ctx, u, options, calls)
+	return batchResult(calls, err)
+}
+
+func (z *calls) BatchUpsert(ctx context.Context, options *CallBatchUpsertOptions) (*CallBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/calls/batch/upsert"
+	calls := &CallBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, calls)
+	return batchResult(calls, err)
 }
 
 func (z *calls) Search(ctx context.Context, options *CallSearchOptions) (*CallSearchResults, error) {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"path"
@@ -17,17 +18,30 @@ import (
 )
 
 const (
-	DefaultAddress = "https://api.hubapi.com"
+	DefaultAddress   = "https://api.hubapi.com"
+	DefaultUserAgent = "hubspot-go"
 )
 
 var (
-	ErrMissingToken = "an API token must be provided to call the Hubspot API"
+	ErrMissingToken           = "an API token must be provided to call the Hubspot API"
+	ErrMissingTokenSource     = "a TokenSource must be provided to call the Hubspot API"
+	ErrMissingDeveloperAPIKey = "a developer API key must be provided to call the Hubspot developer APIs"
 )
 
 type Client struct {
-	baseURL string
-	token   string
-	http    *http.Client
+	baseURL   string
+	tokens    TokenSource
+	http      *http.Client
+	retry     *RetryPolicy
+	userAgent string
+	headers   http.Header
+	logger    *slog.Logger
+
+	limiter       *RateLimiter
+	searchLimiter *RateLimiter
+
+	batchConcurrency int
+	developerAPIKey  string
 
 	Associations        Associations
 	Calls               Calls
@@ -39,43 +53,82 @@ type Client struct {
 	LineItems           LineItems
 	Meetings            Meetings
 	Notes               Notes
+	Objects             Objects
 	Owners              Owners
 	Pipelines           Pipelines
 	Products            Products
+	Properties          Properties
+	Schemas             Schemas
 	Tasks               Tasks
 	Tickets             Tickets
 	Quotes              Quotes
+	Lists               Lists
+	Webhooks            Webhooks
 }
 
-// NewHubspotClient Used to create a new HubSpot Client
-func NewHubspotClient(token string) (*Client, error) {
+// NewClient Creates a new HubSpot Client configured by the given options.
+// Failed requests are retried with DefaultRetryPolicy unless WithRetryPolicy says otherwise.
+func NewClient(token string, opts ...Option) (*Client, error) {
 	if token == "" {
 		return nil, fmt.Errorf(ErrMissingToken)
 	}
-	return newHubspotClientWithDefaults(token), nil
+	return NewClientWithTokenSource(StaticToken(token), opts...)
 }
 
-// NewHubspotClientFromHttpClient Creates a new HubSpot Client, but allows for passing in a custom HTTP client.
-// This can be used for passing contexts throughout SDK usage for additional customization.
-func NewHubspotClientFromHttpClient(token string, httpClient *http.Client) (*Client, error) {
-	if token == "" {
-		return nil, fmt.Errorf(ErrMissingToken)
+// NewClientWithTokenSource Creates a new HubSpot Client which authenticates with tokens from source, e.g. an OAuthTokenSource.
+// Failed requests are retried with DefaultRetryPolicy unless WithRetryPolicy says otherwise.
+func NewClientWithTokenSource(source TokenSource, opts ...Option) (*Client, error) {
+	if source == nil {
+		return nil, fmt.Errorf(ErrMissingTokenSource)
 	}
-	client := newHubspotClientWithDefaults(token)
-	if httpClient != nil {
-		client.http = httpClient
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	client := newHubspotClientWithDefaults(source)
+	o.apply(client)
+	if _, err := url.Parse(client.baseURL); err != nil {
+		return nil, err
 	}
 	return client, nil
 }
 
+// NewHubspotClient Used to create a new HubSpot Client.
+// Like earlier versions it sends every request once, without retries or client-side rate limiting.
+// Use NewClient for a client with DefaultRetryPolicy and the default rate limiters.
+func NewHubspotClient(token string) (*Client, error) {
+	return NewClient(token, legacyOptions()...)
+}
+
+// NewHubspotClientFromHttpClient Creates a new HubSpot Client, but allows for passing in a custom HTTP client.
+// This can be used for passing contexts throughout SDK usage for additional customization.
+// Like earlier versions it sends every request once, without retries or client-side rate limiting.
+// Use NewClient with WithHTTPClient for a client with DefaultRetryPolicy and the default rate limiters.
+func NewHubspotClientFromHttpClient(token string, httpClient *http.Client) (*Client, error) {
+	return NewClient(token, append(legacyOptions(), WithHTTPClient(httpClient))...)
+}
+
+// legacyOptions keeps the clients of the constructors predating NewClient sending each request once.
+func legacyOptions() []Option {
+	return []Option{WithRetryPolicy(nil), WithRateLimiter(nil), WithSearchRateLimiter(nil)}
+}
+
 // Creates a new HubSpot client with defaults
-func newHubspotClientWithDefaults(token string) *Client {
+func newHubspotClientWithDefaults(source TokenSource) *Client {
+	retry := DefaultRetryPolicy
 	client := &Client{
 		baseURL: DefaultAddress,
-		token:   token,
+		tokens:  source,
 		http: &http.Client{
 			Timeout: time.Duration(30 * time.Second),
 		},
+		retry:         &retry,
+		userAgent:     DefaultUserAgent,
+		headers:       make(http.Header),
+		limiter:       NewRateLimiter(DefaultRateLimitMax, DefaultRateLimitInterval),
+		searchLimiter: NewRateLimiter(DefaultSearchRateLimitMax, DefaultSearchRateLimitInterval),
+
+		batchConcurrency: DefaultBatchConcurrency,
 	}
 	client.Associations = &associations{client: client}
 	client.Calls = &calls{client: client}
@@ -87,17 +140,28 @@ func newHubspotClientWithDefaults(token string) *Client {
 	client.LineItems = &lineItems{client: client}
 	client.Meetings = &meetings{client: client}
 	client.Notes = &notes{client: client}
+	client.Objects = &objects{client: client}
 	client.Owners = &owners{client: client}
 	client.Pipelines = &pipelines{client: client}
 	client.Products = &products{client: client}
+	client.Properties = &properties{client: client}
+	client.Schemas = &schemas{client: client}
 	client.Tasks = &tasks{client: client}
 	client.Tickets = &tickets{client: client}
 	client.Quotes = &quotes{client: client}
+	client.Lists = &lists{client: client}
+	client.Webhooks = &webhooks{client: client}
 
 	return client
 }
 
 func (c *Client) newHttpRequest(ctx context.Context, method string, endpoint string, v interface{}) (*http.Request, error) {
+	return c.newHttpRequestWithQuery(ctx, method, endpoint, nil, v)
+}
+
+// newHttpRequestWithQuery is newHttpRequest for endpoints which take query parameters alongside a JSON body,
+// e.g. PUT endpoints. q is encoded like the query of a GET request.
+func (c *Client) newHttpRequestWithQuery(ctx context.Context, method string, endpoint string, q interface{}, v interface{}) (*http.Request, error) {
 	var err error
 	var body []byte
 	var newBody io.Reader
@@ -105,19 +169,18 @@ func (c *Client) newHttpRequest(ctx context.Context, method string, endpoint str
 	if err != nil {
 		return nil, err
 	}
+	if err = addQueryParams(u, q); err != nil {
+		return nil, err
+	}
 
-	reqHeaders := make(http.Header)
+	reqHeaders := c.headers.Clone()
 	reqHeaders.Set("Content-Type", "application/json")
-	reqHeaders.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	reqHeaders.Set("User-Agent", c.userAgent)
 
 	switch method {
 	case "GET", "DELETE":
-		if v != nil {
-			q, err := query.Values(v)
-			if err != nil {
-				return nil, err
-			}
-			u.RawQuery = u.RawQuery + encodeQueryParams(q)
+		if err = addQueryParams(u, v); err != nil {
+			return nil, err
 		}
 	case "POST", "PUT", "PATCH":
 		if v != nil {
@@ -141,34 +204,79 @@ func (c *Client) newHttpRequest(ctx context.Context, method string, endpoint str
 }
 
 func (c *Client) do(req *http.Request, v interface{}) error {
+	var resBody []byte
+	var err error
+	for attempt := 1; ; attempt++ {
+		resBody, err = c.send(req)
+		if err == nil {
+			break
+		}
+		wait, retry := c.retry.shouldRetry(req, err, attempt)
+		if !retry {
+			return err
+		}
+		if c.logger != nil {
+			c.logger.Warn("retrying hubspot request", "method", req.Method, "path", req.URL.Path, "attempt", attempt, "wait", wait, "error", err)
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return err
+		}
+		if req, err = rewindRequest(req); err != nil {
+			return err
+		}
+	}
+
+	if len(resBody) > 0 && v != nil {
+		if err = json.Unmarshal(resBody, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// send performs a single round trip and returns the response body, or an *ErrorResponse for non-2xx statuses
+func (c *Client) send(req *http.Request) ([]byte, error) {
+	limiter := c.limiterFor(req)
+	if limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	// The token is resolved on every attempt so a retried request never reuses an expired access token
+	if !isDeveloperRequest(req) {
+		token, err := c.tokens.Token(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+
 	res, err := c.http.Do(req)
 	if err != nil {
-		return err
+		if isDeveloperRequest(req) {
+			return nil, redactDeveloperAPIKey(err)
+		}
+		return nil, err
 	}
 	defer res.Body.Close()
 
-	statusOk := res.StatusCode >= 200 && res.StatusCode < 300
-	if !statusOk {
-		resBody, err := io.ReadAll(res.Body)
-		if err != nil {
-			return err
-		}
-		errMsg := fmt.Sprintf("%d: %s", res.StatusCode, string(resBody))
-		return fmt.Errorf(errMsg)
+	// Developer API key calls are counted against the developer account, not against this app's quota
+	if limiter != nil && !isDeveloperRequest(req) {
+		limiter.Update(res.Header)
 	}
 
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(resBody) > 0 {
-		if err = json.Unmarshal(resBody, v); err != nil {
-			return err
-		}
+	statusOk := res.StatusCode >= 200 && res.StatusCode < 300
+	if !statusOk {
+		return nil, newErrorResponse(res, resBody)
 	}
-
-	return nil
+	return resBody, nil
 }
 
 func (c *Client) formatUrl(endpoint string) (*url.URL, error) {
@@ -184,6 +292,22 @@ func (c *Client) formatUrl(endpoint string) (*url.URL, error) {
 	return u, nil
 }
 
+// addQueryParams appends the url tagged fields of v to the query of u.
+func addQueryParams(u *url.URL, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	q, err := query.Values(v)
+	if err != nil {
+		return err
+	}
+	if u.RawQuery != "" && len(q) > 0 {
+		u.RawQuery += "&"
+	}
+	u.RawQuery += encodeQueryParams(q)
+	return nil
+}
+
 func encodeQueryParams(v url.Values) string {
 	if v == nil {
 		return ""
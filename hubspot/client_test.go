@@ -0,0 +1,102 @@
+package hubspot
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// newTestClient returns a Client calling a test server which serves handler. Rate limiting is
+// disabled so tests never wait on the token bucket.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient("token", append([]Option{WithBaseURL(srv.URL)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRateLimiter(nil)
+	client.SetSearchRateLimiter(nil)
+	return client
+}
+
+// recordedRequest is a request received by a routeServer.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// testResponse is the canned response of a route, Status defaults to 200.
+type testResponse struct {
+	Status int
+	Body   string
+}
+
+// routeServer answers requests with the response of their "METHOD /path" route and records them.
+// Requests without a route are answered with 404.
+type routeServer struct {
+	mu       sync.Mutex
+	routes   map[string]testResponse
+	requests []recordedRequest
+}
+
+// newRouteClient returns a Client calling a routeServer serving routes.
+func newRouteClient(t *testing.T, routes map[string]testResponse) (*Client, *routeServer) {
+	t.Helper()
+	s := &routeServer{routes: routes}
+	return newTestClient(t, s.handle, WithRetryPolicy(nil)), s
+}
+
+func (s *routeServer) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.requests = append(s.requests, recordedRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+	s.mu.Unlock()
+
+	res, ok := s.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"error","message":"no route","category":"OBJECT_NOT_FOUND"}`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if res.Status != 0 {
+		w.WriteHeader(res.Status)
+	}
+	w.Write([]byte(res.Body))
+}
+
+// last returns the last request received, failing the test when there is none.
+func (s *routeServer) last(t *testing.T) recordedRequest {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		t.Fatal("no request received")
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+// assertJSON fails the test when got and want do not encode the same JSON value.
+func assertJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("invalid expected JSON %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
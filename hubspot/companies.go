@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Companies interface {
 	BatchCreate(ctx context.Context, options *CompanyBatchCreateOptions) (*CompanyBatchOutput, error)
 	BatchRead(ctx context.Context, options *CompanyBatchReadOptions) (*CompanyBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *CompanyBatchUpdateOptions) (*CompanyBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *CompanyBatchUpsertOptions) (*CompanyBatchUpsertOutput, error)
 	Search(ctx context.Context, options *CompanySearchOptions) (*CompanySearchResults, error)
 	Merge(ctx context.Context, options *CompanyMergeOptions) (*Company, error)
 }
@@ -50,11 +52,13 @@ type CompanyList struct {
 }
 
 type Company struct {
-	Id         string            `json:"id"`
-	Properties CompanyProperties `json:"properties"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  string            `json:"updatedAt"`
-	Archived   bool              `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            CompanyProperties  `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type CompanyCreateOrUpdateOptions struct {
@@ -75,6 +79,7 @@ type CompanyBatchOutput struct {
 	RequestedAt string    `json:"requestedAt"`
 	StartedAt   string    `json:"startedAt"`
 	CompletedAt string    `json:"completedAt"`
+	BatchErrors
 }
 
 type CompanyBatchReadOptions struct {
@@ -91,9 +96,38 @@ type CompanyBatchUpdateOptions struct {
 
 type CompanyBatchUpdateProperties struct {
 	Id         string            `json:"id"`
+	IdProperty string            `json:"idProperty,omitempty"`
 	Properties CompanyProperties `json:"properties"`
 }
 
+// CompanyBatchUpsertOptions creates or updates the companies whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type CompanyBatchUpsertOptions struct {
+	IdProperty string                         `json:"-"`
+	Inputs     []CompanyBatchUpdateProperties `json:"inputs"`
+}
+
+func (o CompanyBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options CompanyBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *CompanyBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type CompanyBatchUpsertOutput struct {
+	Status      string                `json:"status"`
+	Results     []CompanyUpsertResult `json:"results"`
+	RequestedAt string                `json:"requestedAt"`
+	StartedAt   string                `json:"startedAt"`
+	CompletedAt string                `json:"completedAt"`
+	BatchErrors
+}
+
+// CompanyUpsertResult is an upserted company. New is true when it was created and false when it was updated.
+type CompanyUpsertResult struct {
+	Company
+	New bool `json:"new"`
+}
+
 type CompanySearchOptions struct {
 	SearchOptions
 }
@@ -232,59 +266,39 @@ func (z *companies) BatchArchive(ctx context.Context, companyIds []string) error
 		options.Inputs = append(options.Inputs, BatchInput{Id: companyId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *companies) BatchCreate(ctx context.Context, options *CompanyBatchCreateOptions) (*CompanyBatchOutput, error) {
 	u := "/crm/v3/objects/companies/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	companies := &CompanyBatchOutput{}
 
-	err = z.client.do(req, companies)
-	if err != nil {
-		return nil, err
-	}
-	return companies, nil
+	err := z.client.doBatch(ctx, u, options, companies)
+	return batchResult(companies, err)
 }
 
 func (z *companies) BatchRead(ctx context.Context, options *CompanyBatchReadOptions) (*CompanyBatchOutput, error) {
 	u := "/crm/v3/objects/companies/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	companies := &CompanyBatchOutput{}
 
-	err = z.client.do(req, companies)
-	if err != nil {
-		return nil, err
-	}
-	return companies, nil
+	err := z.client.doBatch(ctx, u, options, companies)
+	return batchResult(companies, err)
 }
 
 func (z *companies) BatchUpdate(ctx context.Context, options *CompanyBatchUpdateOptions) (*CompanyBatchOutput, error) {
 	u := "/crm/v3/objects/companies/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	companies := &CompanyBatchOutput{}
 
-	err = z.client.do(req, companies)
-	if err != nil {
-		return nil, err
-	}
-	return companies, nil
+	err := z.client.doBatch(ctx, u, options, companies)
+	return batchResult(companies, err)
+}
+
+func (z *companies) BatchUpsert(ctx context.Context, options *CompanyBatchUpsertOptions) (*CompanyBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/companies/batch/upsert"
+	companies := &CompanyBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, companies)
+	return batchResult(companies, err)
 }
 
 func (z *companies) Search(ctx context.Context, options *CompanySearchOptions) (*CompanySearchResults, error) {
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Contacts interface {
 	BatchCreate(ctx context.Context, options *ContactBatchCreateOptions) (*ContactBatchOutput, error)
 	BatchRead(ctx context.Context, options *ContactBatchReadOptions) (*ContactBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *ContactBatchUpdateOptions) (*ContactBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *ContactBatchUpsertOptions) (*ContactBatchUpsertOutput, error)
 	GdprDelete(ctx context.Context, options *ContactGdprDeleteOptions) error
 	Search(ctx context.Context, options *ContactSearchOptions) (*ContactSearchResults, error)
 	Merge(ctx context.Context, options *ContactMergeOptions) (*Contact, error)
@@ -51,11 +53,13 @@ type ContactList struct {
 }
 
 type Contact struct {
-	Id         string            `json:"id"`
-	Properties ContactProperties `json:"properties"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  string            `json:"updatedAt"`
-	Archived   bool              `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            ContactProperties  `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type ContactCreateOrUpdateOptions struct {
@@ -72,6 +76,7 @@ type ContactBatchOutput struct {
 	RequestedAt string    `json:"requestedAt"`
 	StartedAt   string    `json:"startedAt"`
 	CompletedAt string    `json:"completedAt"`
+	BatchErrors
 }
 
 type ContactBatchReadOptions struct {
@@ -88,9 +93,38 @@ type ContactBatchUpdateOptions struct {
 
 type ContactBatchUpdateProperties struct {
 	Id         string            `json:"id"`
+	IdProperty string            `json:"idProperty,omitempty"`
 	Properties ContactProperties `json:"properties"`
 }
 
+// ContactBatchUpsertOptions creates or updates the contacts whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type ContactBatchUpsertOptions struct {
+	IdProperty string                         `json:"-"`
+	Inputs     []ContactBatchUpdateProperties `json:"inputs"`
+}
+
+func (o ContactBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options ContactBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *ContactBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type ContactBatchUpsertOutput struct {
+	Status      string                `json:"status"`
+	Results     []ContactUpsertResult `json:"results"`
+	RequestedAt string                `json:"requestedAt"`
+	StartedAt   string                `json:"startedAt"`
+	CompletedAt string                `json:"completedAt"`
+	BatchErrors
+}
+
+// ContactUpsertResult is an upserted contact. New is true when it was created and false when it was updated.
+type ContactUpsertResult struct {
+	Contact
+	New bool `json:"new"`
+}
+
 type ContactGdprDeleteOptions struct {
 	ObjectId   string `json:"objectId"`
 	IdProperty string `json:"idProperty"`
@@ -233,59 +267,39 @@ func (z *contacts) BatchArchive(ctx context.Context, contactIds []string) error
 		options.Inputs = append(options.Inputs, BatchInput{Id: contactId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *contacts) BatchCreate(ctx context.Context, options *ContactBatchCreateOptions) (*ContactBatchOutput, error) {
 	u := "/crm/v3/objects/contacts/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	contacts := &ContactBatchOutput{}
 
-	err = z.client.do(req, contacts)
-	if err != nil {
-		return nil, err
-	}
-	return contacts, nil
+	err := z.client.doBatch(ctx, u, options, contacts)
+	return batchResult(contacts, err)
 }
 
 func (z *contacts) BatchRead(ctx context.Context, options *ContactBatchReadOptions) (*ContactBatchOutput, error) {
 	u := "/crm/v3/objects/contacts/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	contacts := &ContactBatchOutput{}
 
-	err = z.client.do(req, contacts)
-	if err != nil {
-		return nil, err
-	}
-	return contacts, nil
+	err := z.client.doBatch(ctx, u, options, contacts)
+	return batchResult(contacts, err)
 }
 
 func (z *contacts) BatchUpdate(ctx context.Context, options *ContactBatchUpdateOptions) (*ContactBatchOutput, error) {
 	u := "/crm/v3/objects/contacts/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	contacts := &ContactBatchOutput{}
 
-	err = z.client.do(req, contacts)
-	if err != nil {
-		return nil, err
-	}
-	return contacts, nil
+	err := z.client.doBatch(ctx, u, options, contacts)
+	return batchResult(contacts, err)
+}
+
+func (z *contacts) BatchUpsert(ctx context.Context, options *ContactBatchUpsertOptions) (*ContactBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/contacts/batch/upsert"
+	contacts := &ContactBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, contacts)
+	return batchResult(contacts, err)
 }
 
 func (z *contacts) GdprDelete(ctx context.Context, options *ContactGdprDeleteOptions) error {
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Deals interface {
 	BatchCreate(ctx context.Context, options *DealBatchCreateOptions) (*DealBatchOutput, error)
 	BatchRead(ctx context.Context, options *DealBatchReadOptions) (*DealBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *DealBatchUpdateOptions) (*DealBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *DealBatchUpsertOptions) (*DealBatchUpsertOutput, error)
 	Search(ctx context.Context, options *DealSearchOptions) (*DealSearchResults, error)
 	Merge(ctx context.Context, options *DealMergeOptions) (*Deal, error)
 }
@@ -50,11 +52,13 @@ type DealList struct {
 }
 
 type Deal struct {
-	Id         string         `json:"id"`
-	Properties DealProperties `json:"properties"` //This can be found in deal_properties.go as to not clutter this file
-	CreatedAt  string         `json:"createdAt"`
-	UpdatedAt  string         `json:"updatedAt"`
-	Archived   bool           `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            DealProperties     `json:"properties"` //This can be found in deal_properties.go as to not clutter this file
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type DealCreateOrUpdateOptions struct {
@@ -72,6 +76,7 @@ type DealBatchOutput struct {
 	RequestedAt string `json:"requestedAt"`
 	StartedAt   string `json:"startedAt"`
 	CompletedAt string `json:"completedAt"`
+	BatchErrors
 }
 
 type DealBatchReadOptions struct {
@@ -88,9 +93,38 @@ type DealBatchUpdateOptions struct {
 
 type DealBatchUpdateProperties struct {
 	Id         string         `json:"id"`
+	IdProperty string         `json:"idProperty,omitempty"`
 	Properties DealProperties `json:"properties"` //This can be found in deal_properties.go as to not clutter this file
 }
 
+// DealBatchUpsertOptions creates or updates the deals whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type DealBatchUpsertOptions struct {
+	IdProperty string                      `json:"-"`
+	Inputs     []DealBatchUpdateProperties `json:"inputs"`
+}
+
+func (o DealBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options DealBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *DealBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type DealBatchUpsertOutput struct {
+	Status      string             `json:"status"`
+	Results     []DealUpsertResult `json:"results"`
+	RequestedAt string             `json:"requestedAt"`
+	StartedAt   string             `json:"startedAt"`
+	CompletedAt string             `json:"completedAt"`
+	BatchErrors
+}
+
+// DealUpsertResult is an upserted deal. New is true when it was created and false when it was updated.
+type DealUpsertResult struct {
+	Deal
+	New bool `json:"new"`
+}
+
 type DealSearchOptions struct {
 	SearchOptions
 }
@@ -227,56 +261,39 @@ func (z *deals) BatchArchive(ctx context.Context, dealIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: dealId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *deals) BatchCreate(ctx context.Context, options *DealBatchCreateOptions) (*DealBatchOutput, error) {
 	u := "/crm/v3/objects/deals/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	deals := &DealBatchOutput{}
-	err = z.client.do(req, deals)
-	if err != nil {
-		return nil, err
-	}
-	return deals, nil
+
+	err := z.client.doBatch(ctx, u, options, deals)
+	return batchResult(deals, err)
 }
 
 func (z *deals) BatchRead(ctx context.Context, options *DealBatchReadOptions) (*DealBatchOutput, error) {
 	u := "/crm/v3/objects/deals/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	deals := &DealBatchOutput{}
-	err = z.client.do(req, deals)
-	if err != nil {
-		return nil, err
-	}
-	return deals, nil
+
+	err := z.client.doBatch(ctx, u, options, deals)
+	return batchResult(deals, err)
 }
 
 func (z *deals) BatchUpdate(ctx context.Context, options *DealBatchUpdateOptions) (*DealBatchOutput, error) {
 	u := "/crm/v3/objects/deals/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	deals := &DealBatchOutput{}
-	err = z.client.do(req, deals)
-	if err != nil {
-		return nil, err
-	}
-	return deals, nil
+
+	err := z.client.doBatch(ctx, u, options, deals)
+	return batchResult(deals, err)
+}
+
+func (z *deals) BatchUpsert(ctx context.Context, options *DealBatchUpsertOptions) (*DealBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/deals/batch/upsert"
+	deals := &DealBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, deals)
+	return batchResult(deals, err)
 }
 
 func (z *deals) Search(ctx context.Context, options *DealSearchOptions) (*DealSearchResults, error) {
@@ -54,7 +54,7 @@ type DealProperties struct {
 	HsIsClosed                             string                     `json:"hs_is_closed,omitempty"`
 	HsIsClosedWon                          string                     `json:"hs_is_closed_won,omitempty"`
 	HsIsDealSplit                          string                     `json:"hs_is_deal_split,omitempty"`
-	HsLastmodifieddate                     string                     `json:"hs_lastmodifieddate,omitempty"`
+	HsLastmodifieddate                     DateTime                   `json:"hs_lastmodifieddate,omitempty"`
 	HsLikelihoodToClose                    string                     `json:"hs_likelihood_to_close,omitempty"`
 	HsManualForecastCategory               DealManualForecastCategory `json:"hs_manual_forecast_category,omitempty"`
 	HsMrr                                  string                     `json:"hs_mrr,omitempty"`
@@ -74,11 +74,11 @@ type DealProperties struct {
 	HsUpdatedByUserId                      string                     `json:"hs_updated_by_user_id,omitempty"`
 	HubspotOwnerAssigneddate               string                     `json:"hubspot_owner_assigneddate,omitempty"`
 	DealName                               string                     `json:"dealname,omitempty"`
-	Amount                                 string                     `json:"amount,omitempty"`
+	Amount                                 Number                     `json:"amount,omitempty"`
 	DealStage                              string                     `json:"dealstage,omitempty"`
 	Pipeline                               string                     `json:"pipeline,omitempty"`
-	CloseDate                              string                     `json:"closedate,omitempty"`
-	CreateDate                             string                     `json:"createdate,omitempty"`
+	CloseDate                              DateTime                   `json:"closedate,omitempty"`
+	CreateDate                             DateTime                   `json:"createdate,omitempty"`
 	EngagementsLastMeetingBooked           string                     `json:"engagements_last_meeting_booked,omitempty"`
 	EngagementsLastMeetingBookedCampaign   string                     `json:"engagements_last_meeting_booked_campaign,omitempty"`
 	EngagementsLastMeetingBookedMedium     string                     `json:"engagements_last_meeting_booked_medium,omitempty"`
@@ -91,7 +91,7 @@ type DealProperties struct {
 	NotesNextActivityDate                  string                     `json:"notes_next_activity_date,omitempty"`
 	NumContactedNotes                      string                     `json:"num_contacted_notes,omitempty"`
 	NumNotes                               string                     `json:"num_notes,omitempty"`
-	HsCreatedate                           string                     `json:"hs_createdate,omitempty"`
+	HsCreatedate                           DateTime                   `json:"hs_createdate,omitempty"`
 	HubspotTeamId                          string                     `json:"hubspot_team_id,omitempty"`
 	DealType                               DealType                   `json:"dealtype,omitempty"`
 	HsAllOwnerIds                          string                     `json:"hs_all_owner_ids,omitempty"`
@@ -101,4 +101,16 @@ type DealProperties struct {
 	NumAssociatedContacts                  string                     `json:"num_associated_contacts,omitempty"`
 	ClosedLostReason                       string                     `json:"closed_lost_reason,omitempty"`
 	ClosedWonReason                        string                     `json:"closed_won_reason,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p DealProperties) MarshalJSON() ([]byte, error) {
+	type properties DealProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *DealProperties) UnmarshalJSON(b []byte) error {
+	type properties DealProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
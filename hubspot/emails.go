@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Emails interface {
 	BatchCreate(ctx context.Context, options *EmailBatchCreateOptions) (*EmailBatchOutput, error)
 	BatchRead(ctx context.Context, options *EmailBatchReadOptions) (*EmailBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *EmailBatchUpdateOptions) (*EmailBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *EmailBatchUpsertOptions) (*EmailBatchUpsertOutput, error)
 	Search(ctx context.Context, options *EmailSearchOptions) (*EmailSearchResults, error)
 	Merge(ctx context.Context, options *EmailMergeOptions) (*Email, error)
 }
@@ -50,11 +52,13 @@ type EmailList struct {
 }
 
 type Email struct {
-	Id         string          `json:"id"`
-	Properties EmailProperties `json:"properties"`
-	CreatedAt  string          `json:"createdAt"`
-	UpdatedAt  string          `json:"updatedAt"`
-	Archived   bool            `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            EmailProperties    `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type EmailProperties struct {
@@ -72,6 +76,38 @@ type EmailProperties struct {
 	HsLastModifiedDate     string `json:"hs_lastmodifieddate,omitempty"`
 	HsTimestamp            string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId         string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p EmailProperties) MarshalJSON() ([]byte, error) {
+	type properties EmailProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *EmailProperties) UnmarshalJSON(b []byte) error {
+	type properties EmailProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+}
+
+// CreateOrUpdateProperties returns the writable properties of p, including its custom properties in Raw,
+// so that a read email can be modified and passed to Update without losing them.
+func (p EmailProperties) CreateOrUpdateProperties() EmailCreateOrUpdateProperties {
+	return EmailCreateOrUpdateProperties{
+		HsEmailDirection:       p.HsEmailDirection,
+		HsEmailSenderEmail:     p.HsEmailSenderEmail,
+		HsEmailSenderFirstName: p.HsEmailSenderFirstName,
+		HsEmailSenderLastName:  p.HsEmailSenderLastName,
+		HsEmailStatus:          p.HsEmailStatus,
+		HsEmailSubject:         p.HsEmailSubject,
+		HsEmailText:            p.HsEmailText,
+		HsEmailToEmail:         p.HsEmailToEmail,
+		HsEmailToFirstName:     p.HsEmailToFirstName,
+		HsEmailToLastName:      p.HsEmailToLastName,
+		HsTimestamp:            p.HsTimestamp,
+		HubSpotOwnerId:         p.HubSpotOwnerId,
+		Raw:                    writableRawProperties(p.Raw),
+	}
 }
 
 type EmailCreateOrUpdateOptions struct {
@@ -91,6 +127,18 @@ type EmailCreateOrUpdateProperties struct {
 	HsEmailToLastName      string `json:"hs_email_to_lastname,omitempty"`
 	HsTimestamp            string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId         string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p EmailCreateOrUpdateProperties) MarshalJSON() ([]byte, error) {
+	type properties EmailCreateOrUpdateProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *EmailCreateOrUpdateProperties) UnmarshalJSON(b []byte) error {
+	type properties EmailCreateOrUpdateProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type EmailReadQuery struct {
@@ -107,6 +155,7 @@ type EmailBatchOutput struct {
 	RequestedAt string  `json:"requestedAt"`
 	StartedAt   string  `json:"startedAt"`
 	CompletedAt string  `json:"completedAt"`
+	BatchErrors
 }
 
 type EmailBatchReadOptions struct {
@@ -123,9 +172,38 @@ type EmailBatchUpdateOptions struct {
 
 type EmailBatchUpdateProperties struct {
 	Id         string                        `json:"id"`
+	IdProperty string                        `json:"idProperty,omitempty"`
 	Properties EmailCreateOrUpdateProperties `json:"properties"`
 }
 
+// EmailBatchUpsertOptions creates or updates the emails whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type EmailBatchUpsertOptions struct {
+	IdProperty string                       `json:"-"`
+	Inputs     []EmailBatchUpdateProperties `json:"inputs"`
+}
+
+func (o EmailBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options EmailBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *EmailBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type EmailBatchUpsertOutput struct {
+	Status      string              `json:"status"`
+	Results     []EmailUpsertResult `json:"results"`
+	RequestedAt string              `json:"requestedAt"`
+	StartedAt   string              `json:"startedAt"`
+	CompletedAt string              `json:"completedAt"`
+	BatchErrors
+}
+
+// EmailUpsertResult is an upserted email. New is true when it was created and false when it was updated.
+type EmailUpsertResult struct {
+	Email
+	New bool `json:"new"`
+}
+
 type EmailSearchOptions struct {
 	SearchOptions
 }
@@ -264,59 +342,39 @@ func (z *emails) BatchArchive(ctx context.Context, emailIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: emailId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *emails) BatchCreate(ctx context.Context, options *EmailBatchCreateOptions) (*EmailBatchOutput, error) {
 	u := "/crm/v3/objects/emails/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	emails := &EmailBatchOutput{}
 
-	err = z.client.do(req, emails)
-	if err != nil {
-		return nil, err
-	}
-	return emails, nil
+	err := z.client.doBatch(ctx, u, options, emails)
+	return batchResult(emails, err)
 }
 
 func (z *emails) BatchRead(ctx context.Context, options *EmailBatchReadOptions) (*EmailBatchOutput, error) {
 	u := "/crm/v3/objects/emails/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	emails := &EmailBatchOutput{}
 
-	err = z.client.do(req, emails)
-	if err != nil {
-		return nil, err
-	}
-	return emails, nil
+	err := z.client.doBatch(ctx, u, options, emails)
+	return batchResult(emails, err)
 }
 
 func (z *emails) BatchUpdate(ctx context.Context, options *EmailBatchUpdateOptions) (*EmailBatchOutput, error) {
 	u := "/crm/v3/objects/emails/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	emails := &EmailBatchOutput{}
 
-	err = z.client.do(req, emails)
-	if err != nil {
-		return nil, err
-	}
-	return emails, nil
+	err := z.client.doBatch(ctx, u, options, emails)
+	return batchResult(emails, err)
+}
+
+func (z *emails) BatchUpsert(ctx context.Context, options *EmailBatchUpsertOptions) (*EmailBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/emails/batch/upsert"
+	emails := &EmailBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, emails)
+	return batchResult(emails, err)
 }
 
 func (z *emails) Search(ctx context.Context, options *EmailSearchOptions) (*EmailSearchResults, error) {
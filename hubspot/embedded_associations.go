@@ -0,0 +1,66 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+)
+
+// ObjectAssociations holds the associations HubSpot embeds in an object when ListQuery.Associations or
+// ReadQuery.Associations is set, keyed by target object type. Only the first page of each target object
+// type is embedded, see EmbeddedAssociationPager for the rest.
+type ObjectAssociations map[ObjectType]EmbeddedAssociations
+
+type EmbeddedAssociations struct {
+	Results []EmbeddedAssociation `json:"results"`
+	Pagination
+}
+
+type EmbeddedAssociation struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// Ids returns the distinct ids of the embedded objects of toObjectType. An object associated with
+// more than one association type is listed once.
+func (a ObjectAssociations) Ids(toObjectType ObjectType) []string {
+	ids := make([]string, 0, len(a[toObjectType].Results))
+	for _, r := range a[toObjectType].Results {
+		if !containsString(ids, r.Id) {
+			ids = append(ids, r.Id)
+		}
+	}
+	return ids
+}
+
+// HasMore reports whether HubSpot has more associations of toObjectType than were embedded.
+func (a ObjectAssociations) HasMore(toObjectType ObjectType) bool {
+	return a[toObjectType].NextAfter() != ""
+}
+
+// EmbeddedAssociationPager walks every association of an object to toObjectType, starting with the page
+// embedded in the object and fetching the following pages from HubSpot. A pager resumed with StartAfter
+// skips the embedded page and fetches from the cursor.
+func EmbeddedAssociationPager(client *Client, fromObjectType ObjectType, fromObjectId string, toObjectType ObjectType, embedded ObjectAssociations) *Pager[EmbeddedAssociation] {
+	first := true
+	return NewPager(func(ctx context.Context, after string) ([]EmbeddedAssociation, string, error) {
+		if first && after == "" {
+			first = false
+			page := embedded[toObjectType]
+			return page.Results, page.NextAfter(), nil
+		}
+		first = false
+		u := fmt.Sprintf("crm/v3/objects/%s/%s/associations/%s", fromObjectType, fromObjectId, toObjectType)
+		req, err := client.newHttpRequest(ctx, "GET", u, &ListAssociationsQuery{After: after})
+		if err != nil {
+			return nil, "", err
+		}
+
+		page := &EmbeddedAssociations{}
+
+		err = client.do(req, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Results, page.NextAfter(), nil
+	})
+}
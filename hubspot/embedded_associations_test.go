@@ -0,0 +1,56 @@
+package hubspot
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestEmbeddedAssociationPager(t *testing.T) {
+	embedded := ObjectAssociations{
+		ObjectTypeContacts: {
+			Results:    []EmbeddedAssociation{{Id: "1", Type: "deal_to_contact"}, {Id: "2", Type: "deal_to_contact"}},
+			Pagination: Pagination{Paging: Paging{Next: Next{After: "2"}}},
+		},
+	}
+	routes := map[string]testResponse{
+		"GET /crm/v3/objects/deals/7/associations/contacts": {Body: `{"results":[{"id":"3","type":"deal_to_contact"}]}`},
+	}
+
+	t.Run("embedded page first", func(t *testing.T) {
+		client, s := newRouteClient(t, routes)
+		items, err := EmbeddedAssociationPager(client, ObjectTypeDeals, "7", ObjectTypeContacts, embedded).CollectAll(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := embeddedIds(items); !reflect.DeepEqual(got, []string{"1", "2", "3"}) {
+			t.Errorf("ids = %v", got)
+		}
+		if len(s.requests) != 1 || s.last(t).Query.Get("after") != "2" {
+			t.Errorf("requests = %+v, want one request after the embedded page", s.requests)
+		}
+	})
+
+	t.Run("resumed", func(t *testing.T) {
+		client, s := newRouteClient(t, routes)
+		pager := EmbeddedAssociationPager(client, ObjectTypeDeals, "7", ObjectTypeContacts, embedded).StartAfter("2")
+		items, err := pager.CollectAll(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := embeddedIds(items); !reflect.DeepEqual(got, []string{"3"}) {
+			t.Errorf("ids = %v, want only the items after the cursor", got)
+		}
+		if len(s.requests) != 1 || s.last(t).Query.Get("after") != "2" {
+			t.Errorf("requests = %+v, want one request from the cursor", s.requests)
+		}
+	})
+}
+
+func embeddedIds(associations []EmbeddedAssociation) []string {
+	ids := make([]string, 0, len(associations))
+	for _, a := range associations {
+		ids = append(ids, a.Id)
+	}
+	return ids
+}
@@ -2,9 +2,31 @@ package hubspot
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+var (
+	ErrBadRequest   = errors.New("hubspot: bad request")
+	ErrUnauthorized = errors.New("hubspot: unauthorized")
+	ErrForbidden    = errors.New("hubspot: forbidden")
+	ErrNotFound     = errors.New("hubspot: not found")
+	ErrConflict     = errors.New("hubspot: conflict")
+	ErrRateLimited  = errors.New("hubspot: rate limited")
+	ErrValidation   = errors.New("hubspot: validation error")
+	ErrServer       = errors.New("hubspot: server error")
+)
+
+const (
+	ErrorCategoryValidation     = "VALIDATION_ERROR"
+	ErrorCategoryRateLimits     = "RATE_LIMITS"
+	ErrorCategoryObjectNotFound = "OBJECT_NOT_FOUND"
+	ErrorCategoryConflict       = "CONFLICT"
+)
+
+// ErrorResponse is returned by every service method when HubSpot responds with a non-2xx status.
+// The JSON fields are decoded from the response body; StatusCode, Header and Body describe the raw response.
 type ErrorResponse struct {
 	SubCategory   string                 `json:"subCategory,omitempty"`
 	Context       map[string]interface{} `json:"context,omitempty"`
@@ -14,6 +36,10 @@ type ErrorResponse struct {
 	Category      string                 `json:"category,omitempty"`
 	Errors        []ErrorObject          `json:"errors,omitempty"`
 	Status        string                 `json:"status,omitempty"`
+
+	StatusCode int         `json:"-"`
+	Header     http.Header `json:"-"`
+	Body       []byte      `json:"-"`
 }
 
 type ErrorObject struct {
@@ -24,19 +50,122 @@ type ErrorObject struct {
 	Message     string                 `json:"message,omitempty"`
 }
 
+// BatchError is a failure reported by a batch endpoint for some of its inputs, alongside the results of the
+// inputs which succeeded. Context lists the affected inputs, e.g. under "ids".
+type BatchError struct {
+	Status      string              `json:"status,omitempty"`
+	Id          string              `json:"id,omitempty"`
+	Category    string              `json:"category,omitempty"`
+	SubCategory string              `json:"subCategory,omitempty"`
+	Message     string              `json:"message,omitempty"`
+	Context     map[string][]string `json:"context,omitempty"`
+	Errors      []ErrorObject       `json:"errors,omitempty"`
+	Links       map[string]string   `json:"links,omitempty"`
+}
+
+func (e BatchError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Category, e.Message)
+}
+
+// BatchErrors is embedded in every batch output. HubSpot answers a batch call in which some inputs failed
+// with a 207 Multi-Status response holding the results of the other inputs and an error per failure.
+type BatchErrors struct {
+	NumErrors int          `json:"numErrors,omitempty"`
+	Errors    []BatchError `json:"errors,omitempty"`
+}
+
+// HasErrors reports whether some inputs of the batch call failed.
+func (e BatchErrors) HasErrors() bool {
+	return e.NumErrors > 0 || len(e.Errors) > 0
+}
+
+// FailedIds returns the ids of the failed inputs, as listed in the context of each error.
+func (e BatchErrors) FailedIds() []string {
+	var ids []string
+	for _, err := range e.Errors {
+		for _, id := range err.Context["ids"] {
+			if !containsString(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// newErrorResponse builds an ErrorResponse from a non-2xx response. The body is decoded on a best effort basis
+// since not every error (e.g. gateway errors) carries a JSON payload.
+func newErrorResponse(res *http.Response, body []byte) *ErrorResponse {
+	errResponse := &ErrorResponse{}
+	_ = json.Unmarshal(body, errResponse)
+	errResponse.StatusCode = res.StatusCode
+	errResponse.Header = res.Header
+	errResponse.Body = body
+	return errResponse
+}
+
 func (e *ErrorResponse) Error() string {
+	if len(e.Body) > 0 {
+		return fmt.Sprintf("%d: %s", e.StatusCode, string(e.Body))
+	}
 	j, err := json.Marshal(e)
 	if err != nil {
 		return fmt.Sprintf("failed to marshal ErrorResponse: %v", err)
 	}
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("%d: %s", e.StatusCode, string(j))
+	}
 	return string(j)
 }
 
+// Is allows the sentinel errors to be matched with errors.Is.
+func (e *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Category == ErrorCategoryObjectNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict || e.Category == ErrorCategoryConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.Category == ErrorCategoryRateLimits
+	case ErrValidation:
+		return e.Category == ErrorCategoryValidation
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// IsNotFound reports whether err is a HubSpot 404 response.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err is a HubSpot 409 response.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited reports whether err is a HubSpot 429 response.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsValidationError reports whether err is a HubSpot VALIDATION_ERROR response.
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// GetErrorResponseFromError returns the ErrorResponse wrapped in respErr, and an error when respErr
+// does not wrap one, e.g. for transport failures or a cancelled context.
 func GetErrorResponseFromError(respErr error) (*ErrorResponse, error) {
-	errResponse := &ErrorResponse{}
-	err := json.Unmarshal([]byte(respErr.Error()), &errResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal ErrorResponse: %v, original error: %v", err, respErr)
+	var errResponse *ErrorResponse
+	if errors.As(respErr, &errResponse) {
+		return errResponse, nil
 	}
-	return errResponse, nil
+	return nil, fmt.Errorf("hubspot: not an error response: %v", respErr)
 }
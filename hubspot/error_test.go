@@ -0,0 +1,86 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorResponseSentinels(t *testing.T) {
+	sentinels := []error{ErrBadRequest, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrRateLimited, ErrValidation, ErrServer}
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"not found", http.StatusNotFound, `{"status":"error","message":"Object not found","category":"OBJECT_NOT_FOUND","correlationId":"c-404"}`, ErrNotFound},
+		{"rate limited", http.StatusTooManyRequests, `{"status":"error","message":"You have reached your secondly limit","category":"RATE_LIMITS","correlationId":"c-429"}`, ErrRateLimited},
+		{"unauthorized", http.StatusUnauthorized, `{"status":"error","message":"Authentication credentials not found","category":"INVALID_AUTHENTICATION","correlationId":"c-401"}`, ErrUnauthorized},
+		{"conflict", http.StatusConflict, `{"status":"error","message":"Contact already exists","category":"CONFLICT","correlationId":"c-409"}`, ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("X-Hubspot-Correlation-Id", "header-id")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}, WithRetryPolicy(nil), WithRateLimiter(nil))
+
+			_, err := client.Contacts.Read(context.Background(), nil, "1")
+			err = fmt.Errorf("reading contact: %w", err)
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+					t.Errorf("errors.Is(err, %v) = %v", sentinel, got)
+				}
+			}
+
+			errResponse, convErr := GetErrorResponseFromError(err)
+			if convErr != nil {
+				t.Fatal(convErr)
+			}
+			if errResponse.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", errResponse.StatusCode, tt.status)
+			}
+			if errResponse.Header.Get("X-Hubspot-Correlation-Id") != "header-id" {
+				t.Errorf("Header = %v", errResponse.Header)
+			}
+			if string(errResponse.Body) != tt.body {
+				t.Errorf("Body = %s, want %s", errResponse.Body, tt.body)
+			}
+			if errResponse.CorrelationId == "" || errResponse.Message == "" {
+				t.Errorf("body not decoded: %+v", errResponse)
+			}
+		})
+	}
+}
+
+func TestErrorResponseWithoutJSONBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>Bad Gateway</html>"))
+	}, WithRetryPolicy(nil))
+
+	_, err := client.Contacts.Read(context.Background(), nil, "1")
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("err = %v, want ErrServer", err)
+	}
+	errResponse, convErr := GetErrorResponseFromError(err)
+	if convErr != nil {
+		t.Fatal(convErr)
+	}
+	if errResponse.StatusCode != http.StatusBadGateway || string(errResponse.Body) != "<html>Bad Gateway</html>" {
+		t.Errorf("error response = %+v", errResponse)
+	}
+}
+
+func TestGetErrorResponseFromOtherErrors(t *testing.T) {
+	for _, err := range []error{context.Canceled, errors.New(`{"message":"not from a response"}`)} {
+		if errResponse, convErr := GetErrorResponseFromError(err); errResponse != nil || convErr == nil {
+			t.Errorf("GetErrorResponseFromError(%v) = %+v, %v, want an error", err, errResponse, convErr)
+		}
+	}
+}
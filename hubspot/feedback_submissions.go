@@ -41,8 +41,10 @@ type FeedbackSubmissionList struct {
 }
 
 type FeedbackSubmission struct {
-	Id         string                       `json:"id"`
-	Properties FeedbackSubmissionProperties `json:"properties"`
+	Id                    string                       `json:"id"`
+	Properties            FeedbackSubmissionProperties `json:"properties"`
+	PropertiesWithHistory PropertyHistory              `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations           `json:"associations,omitempty"`
 }
 
 type FeedbackSubmissionProperties struct {
@@ -55,6 +57,18 @@ type FeedbackSubmissionProperties struct {
 	HsSurveyName     string `json:"hs_survey_name,omitempty"`
 	HsSurveyType     string `json:"hs_survey_type,omitempty"`
 	HsValue          string `json:"hs_value,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p FeedbackSubmissionProperties) MarshalJSON() ([]byte, error) {
+	type properties FeedbackSubmissionProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *FeedbackSubmissionProperties) UnmarshalJSON(b []byte) error {
+	type properties FeedbackSubmissionProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type FeedbackSubmissionReadQuery struct {
@@ -76,6 +90,7 @@ type FeedbackSubmissionBatchReadResults struct {
 	StartedAt   string               `json:"startedAt"`
 	UpdatedAt   string               `json:"updatedAt"`
 	Archived    bool                 `json:"archived"`
+	BatchErrors
 }
 
 type FeedbackSubmissionSearchOptions struct {
@@ -138,18 +153,10 @@ func (z *feedbackSubmissions) Read(ctx context.Context, feedbackSubmissionId str
 
 func (z *feedbackSubmissions) BatchRead(ctx context.Context, options *FeedbackSubmissionBatchReadOptions) (*FeedbackSubmissionBatchReadResults, error) {
 	u := "/crm/v3/objects/feedback_submissions/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	fsbrr := &FeedbackSubmissionBatchReadResults{}
 
-	err = z.client.do(req, fsbrr)
-	if err != nil {
-		return nil, err
-	}
-	return fsbrr, nil
+	err := z.client.doBatch(ctx, u, options, fsbrr)
+	return batchResult(fsbrr, err)
 }
 
 func (z *feedbackSubmissions) Search(ctx context.Context, options *FeedbackSubmissionSearchOptions) (*FeedbackSubmissionSearchResults, error) {
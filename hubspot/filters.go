@@ -0,0 +1,188 @@
+package hubspot
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Limits HubSpot enforces on the filters of a single search request.
+const (
+	MaxFilterGroups    = 5
+	MaxFiltersPerGroup = 6
+	MaxFilters         = 18
+)
+
+var ErrInvalidFilter = errors.New("hubspot: invalid search filter")
+
+// FilterBuilder builds the FilterGroups of a SearchOptions. Filters joined with And are placed in the same
+// group, Or starts a new group:
+//
+//	groups, err := hubspot.Where("lifecyclestage").Eq("customer").
+//		And("createdate").Gte("1704067200000").
+//		Or("hs_lead_status").In("NEW", "OPEN").
+//		Build()
+type FilterBuilder struct {
+	groups []FilterGroups
+}
+
+// FilterCondition is a property awaiting its operator.
+type FilterCondition struct {
+	builder  *FilterBuilder
+	property string
+	newGroup bool
+}
+
+// Where starts a new FilterBuilder with a filter on property.
+func Where(property string) *FilterCondition {
+	return &FilterCondition{builder: &FilterBuilder{}, property: property, newGroup: true}
+}
+
+// And adds a filter on property to the current group.
+func (b *FilterBuilder) And(property string) *FilterCondition {
+	return &FilterCondition{builder: b, property: property}
+}
+
+// Or adds a filter on property to a new group.
+func (b *FilterBuilder) Or(property string) *FilterCondition {
+	return &FilterCondition{builder: b, property: property, newGroup: true}
+}
+
+// Build validates the filters against HubSpot's limits and returns them as FilterGroups.
+func (b *FilterBuilder) Build() ([]FilterGroups, error) {
+	if err := ValidateFilterGroups(b.groups); err != nil {
+		return nil, err
+	}
+	return b.groups, nil
+}
+
+// Apply validates the filters and sets them on options.
+func (b *FilterBuilder) Apply(options *SearchOptions) error {
+	groups, err := b.Build()
+	if err != nil {
+		return err
+	}
+	options.FilterGroups = groups
+	return nil
+}
+
+func (c *FilterCondition) add(filter Filters) *FilterBuilder {
+	filter.PropertyName = c.property
+	b := c.builder
+	if c.newGroup || len(b.groups) == 0 {
+		b.groups = append(b.groups, FilterGroups{})
+	}
+	last := &b.groups[len(b.groups)-1]
+	last.Filters = append(last.Filters, filter)
+	return b
+}
+
+func (c *FilterCondition) Eq(value string) *FilterBuilder {
+	return c.add(Filters{Operator: EqualTo, Value: value})
+}
+
+func (c *FilterCondition) Neq(value string) *FilterBuilder {
+	return c.add(Filters{Operator: NotEqualTo, Value: value})
+}
+
+func (c *FilterCondition) Lt(value string) *FilterBuilder {
+	return c.add(Filters{Operator: LessThan, Value: value})
+}
+
+func (c *FilterCondition) Lte(value string) *FilterBuilder {
+	return c.add(Filters{Operator: LessThanEqualTo, Value: value})
+}
+
+func (c *FilterCondition) Gt(value string) *FilterBuilder {
+	return c.add(Filters{Operator: GreaterThan, Value: value})
+}
+
+func (c *FilterCondition) Gte(value string) *FilterBuilder {
+	return c.add(Filters{Operator: GreaterThanEqualTo, Value: value})
+}
+
+// Between matches values from low to high inclusive.
+func (c *FilterCondition) Between(low string, high string) *FilterBuilder {
+	return c.add(Filters{Operator: Between, Value: low, HighValue: high})
+}
+
+func (c *FilterCondition) In(values ...string) *FilterBuilder {
+	return c.add(Filters{Operator: In, Values: values})
+}
+
+func (c *FilterCondition) NotIn(values ...string) *FilterBuilder {
+	return c.add(Filters{Operator: NotIn, Values: values})
+}
+
+func (c *FilterCondition) HasProperty() *FilterBuilder {
+	return c.add(Filters{Operator: HasProperty})
+}
+
+func (c *FilterCondition) NotHasProperty() *FilterBuilder {
+	return c.add(Filters{Operator: NotHasProperty})
+}
+
+func (c *FilterCondition) ContainsToken(token string) *FilterBuilder {
+	return c.add(Filters{Operator: ContainsToken, Value: token})
+}
+
+func (c *FilterCondition) NotContainsToken(token string) *FilterBuilder {
+	return c.add(Filters{Operator: NotContainsToken, Value: token})
+}
+
+// ValidateFilterGroups checks groups against HubSpot's filter limits and operator rules.
+func ValidateFilterGroups(groups []FilterGroups) error {
+	if len(groups) > MaxFilterGroups {
+		return fmt.Errorf("%w: %d filter groups exceed the limit of %d", ErrInvalidFilter, len(groups), MaxFilterGroups)
+	}
+	total := 0
+	for i, group := range groups {
+		if len(group.Filters) == 0 {
+			return fmt.Errorf("%w: filter group %d is empty", ErrInvalidFilter, i)
+		}
+		if len(group.Filters) > MaxFiltersPerGroup {
+			return fmt.Errorf("%w: filter group %d has %d filters, the limit is %d", ErrInvalidFilter, i, len(group.Filters), MaxFiltersPerGroup)
+		}
+		total += len(group.Filters)
+		for _, filter := range group.Filters {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if total > MaxFilters {
+		return fmt.Errorf("%w: %d filters exceed the limit of %d", ErrInvalidFilter, total, MaxFilters)
+	}
+	return nil
+}
+
+// Validate checks that the filter uses the value fields its operator expects. HubSpot rejects single value
+// operators such as EQ with an empty value, so they are refused here.
+func (f Filters) Validate() error {
+	if f.PropertyName == "" {
+		return fmt.Errorf("%w: missing property name", ErrInvalidFilter)
+	}
+	switch f.Operator {
+	case Between:
+		if f.Value == "" || f.HighValue == "" || len(f.Values) > 0 {
+			return fmt.Errorf("%w: %s on %q requires value and highValue", ErrInvalidFilter, f.Operator, f.PropertyName)
+		}
+	case In, NotIn:
+		if len(f.Values) == 0 || f.Value != "" || f.HighValue != "" {
+			return fmt.Errorf("%w: %s on %q requires values", ErrInvalidFilter, f.Operator, f.PropertyName)
+		}
+	case HasProperty, NotHasProperty:
+		if f.Value != "" || f.HighValue != "" || len(f.Values) > 0 {
+			return fmt.Errorf("%w: %s on %q does not take a value", ErrInvalidFilter, f.Operator, f.PropertyName)
+		}
+	case EqualTo, NotEqualTo, LessThan, LessThanEqualTo, GreaterThan, GreaterThanEqualTo, ContainsToken, NotContainsToken:
+		if f.Value == "" {
+			return fmt.Errorf("%w: %s on %q requires a value, use %s to match records without one", ErrInvalidFilter, f.Operator, f.PropertyName, NotHasProperty)
+		}
+		if f.HighValue != "" || len(f.Values) > 0 {
+			return fmt.Errorf("%w: %s on %q takes a single value", ErrInvalidFilter, f.Operator, f.PropertyName)
+		}
+	default:
+		return fmt.Errorf("%w: unknown operator %q on %q", ErrInvalidFilter, f.Operator, f.PropertyName)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package hubspot
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func filterGroups(groups int, filtersPerGroup int) []FilterGroups {
+	out := make([]FilterGroups, 0, groups)
+	for i := 0; i < groups; i++ {
+		group := FilterGroups{}
+		for j := 0; j < filtersPerGroup; j++ {
+			group.Filters = append(group.Filters, Filters{PropertyName: "p" + strconv.Itoa(j), Operator: EqualTo, Value: "v"})
+		}
+		out = append(out, group)
+	}
+	return out
+}
+
+func TestValidateFilterGroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []FilterGroups
+		valid  bool
+	}{
+		{"no groups", nil, true},
+		{"group limit", filterGroups(MaxFilterGroups, 1), true},
+		{"too many groups", filterGroups(MaxFilterGroups+1, 1), false},
+		{"filters per group limit", filterGroups(1, MaxFiltersPerGroup), true},
+		{"too many filters in a group", filterGroups(1, MaxFiltersPerGroup+1), false},
+		{"total filter limit", filterGroups(MaxFilters/MaxFiltersPerGroup, MaxFiltersPerGroup), true},
+		{"too many filters", append(filterGroups(MaxFilters/MaxFiltersPerGroup, MaxFiltersPerGroup), filterGroups(1, 1)...), false},
+		{"empty group", []FilterGroups{{}}, false},
+		{"invalid filter", []FilterGroups{{Filters: []Filters{{PropertyName: "email", Operator: EqualTo}}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFilterGroups(tt.groups)
+			if tt.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidFilter) {
+				t.Fatalf("err = %v, want ErrInvalidFilter", err)
+			}
+		})
+	}
+}
+
+func TestFiltersValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filters
+		valid  bool
+	}{
+		{"eq", Filters{PropertyName: "email", Operator: EqualTo, Value: "a@example.com"}, true},
+		{"eq without value", Filters{PropertyName: "email", Operator: EqualTo}, false},
+		{"gte without value", Filters{PropertyName: "createdate", Operator: GreaterThanEqualTo}, false},
+		{"contains token without value", Filters{PropertyName: "email", Operator: ContainsToken}, false},
+		{"eq with values", Filters{PropertyName: "email", Operator: EqualTo, Value: "a", Values: []string{"b"}}, false},
+		{"eq with high value", Filters{PropertyName: "email", Operator: EqualTo, Value: "a", HighValue: "b"}, false},
+		{"missing property", Filters{Operator: EqualTo, Value: "a"}, false},
+		{"between", Filters{PropertyName: "amount", Operator: Between, Value: "1", HighValue: "2"}, true},
+		{"between without high value", Filters{PropertyName: "amount", Operator: Between, Value: "1"}, false},
+		{"in", Filters{PropertyName: "dealstage", Operator: In, Values: []string{"won"}}, true},
+		{"in without values", Filters{PropertyName: "dealstage", Operator: In}, false},
+		{"not in with value", Filters{PropertyName: "dealstage", Operator: NotIn, Value: "won", Values: []string{"lost"}}, false},
+		{"has property", Filters{PropertyName: "phone", Operator: HasProperty}, true},
+		{"has property with value", Filters{PropertyName: "phone", Operator: HasProperty, Value: "1"}, false},
+		{"unknown operator", Filters{PropertyName: "phone", Operator: "LIKE", Value: "1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidFilter) {
+				t.Fatalf("err = %v, want ErrInvalidFilter", err)
+			}
+		})
+	}
+}
+
+func TestFilterBuilder(t *testing.T) {
+	groups, err := Where("lifecyclestage").Eq("customer").
+		And("createdate").Gte("1704067200000").
+		Or("hs_lead_status").In("NEW", "OPEN").
+		And("phone").HasProperty().
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []FilterGroups{
+		{Filters: []Filters{
+			{PropertyName: "lifecyclestage", Operator: EqualTo, Value: "customer"},
+			{PropertyName: "createdate", Operator: GreaterThanEqualTo, Value: "1704067200000"},
+		}},
+		{Filters: []Filters{
+			{PropertyName: "hs_lead_status", Operator: In, Values: []string{"NEW", "OPEN"}},
+			{PropertyName: "phone", Operator: HasProperty},
+		}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Fatalf("groups = %+v, want %+v", groups, want)
+	}
+
+	options := &SearchOptions{}
+	if err := Where("email").Eq("a@example.com").Apply(options); err != nil || len(options.FilterGroups) != 1 {
+		t.Fatalf("Apply = %v, groups %+v", err, options.FilterGroups)
+	}
+}
+
+func TestFilterBuilderRejectsEmptyValue(t *testing.T) {
+	if _, err := Where("email").Eq("").Build(); !errors.Is(err, ErrInvalidFilter) {
+		t.Fatalf("err = %v, want ErrInvalidFilter", err)
+	}
+	options := &SearchOptions{}
+	if err := Where("email").Eq("a").And("firstname").Neq("").Apply(options); !errors.Is(err, ErrInvalidFilter) {
+		t.Fatalf("err = %v, want ErrInvalidFilter", err)
+	}
+	if options.FilterGroups != nil {
+		t.Fatalf("Apply set invalid groups %+v", options.FilterGroups)
+	}
+}
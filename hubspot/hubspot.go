@@ -61,13 +61,44 @@ const (
 	NotContainsToken   FilterOperator = "NOT_CONTAINS_TOKEN"
 )
 
+type SortDirection string
+
+const (
+	Ascending  SortDirection = "ASCENDING"
+	Descending SortDirection = "DESCENDING"
+)
+
+// ObjectIdProperty is unique per object, sorting on it yields a deterministic order.
+const ObjectIdProperty = "hs_object_id"
+
+type Sort struct {
+	PropertyName string        `json:"propertyName"`
+	Direction    SortDirection `json:"direction,omitempty"`
+}
+
+// SortAscending sorts search results by property, lowest value first.
+func SortAscending(property string) Sort {
+	return Sort{PropertyName: property, Direction: Ascending}
+}
+
+// SortDescending sorts search results by property, highest value first.
+func SortDescending(property string) Sort {
+	return Sort{PropertyName: property, Direction: Descending}
+}
+
+// StableSort returns an ascending sort on hs_object_id, which makes repeated searches return the same
+// order, e.g. for exports. HubSpot applies a single sort per search, so pass it as the only element of Sorts.
+func StableSort() Sort {
+	return SortAscending(ObjectIdProperty)
+}
+
 type SearchOptions struct {
 	FilterGroups []FilterGroups `json:"filterGroups,omitempty"`
-	Sorts        []string       `json:"sorts,omitempty"`
+	Sorts        []Sort         `json:"sorts,omitempty"`
 	Query        string         `json:"query,omitempty"`
 	Properties   []string       `json:"properties,omitempty"`
 	Limit        int32          `json:"limit,omitempty"`
-	After        int32          `json:"after,omitempty"`
+	After        string         `json:"after,omitempty"`
 }
 
 type FilterGroups struct {
@@ -76,6 +107,7 @@ type FilterGroups struct {
 
 type Filters struct {
 	Value        string         `json:"value,omitempty"`
+	HighValue    string         `json:"highValue,omitempty"`
 	Values       []string       `json:"values,omitempty"`
 	PropertyName string         `json:"propertyName,omitempty"`
 	Operator     FilterOperator `json:"operator,omitempty"`
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type LineItems interface {
 	BatchCreate(ctx context.Context, options *LineItemBatchCreateOptions) (*LineItemBatchOutput, error)
 	BatchRead(ctx context.Context, options *LineItemBatchReadOptions) (*LineItemBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *LineItemBatchUpdateOptions) (*LineItemBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *LineItemBatchUpsertOptions) (*LineItemBatchUpsertOutput, error)
 	Search(ctx context.Context, options *LineItemSearchOptions) (*LineItemSearchResults, error)
 	Merge(ctx context.Context, options *LineItemMergeOptions) (*LineItem, error)
 }
@@ -50,11 +52,13 @@ type LineItemList struct {
 }
 
 type LineItem struct {
-	Id         string             `json:"id"`
-	Properties LineItemProperties `json:"properties"`
-	CreatedAt  string             `json:"createdAt"`
-	UpdatedAt  string             `json:"updatedAt"`
-	Archived   bool               `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            LineItemProperties `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type LineItemCreateOrUpdateOptions struct {
@@ -72,6 +76,7 @@ type LineItemBatchOutput struct {
 	RequestedAt string     `json:"requestedAt"`
 	StartedAt   string     `json:"startedAt"`
 	CompletedAt string     `json:"completedAt"`
+	BatchErrors
 }
 
 type LineItemBatchReadOptions struct {
@@ -88,9 +93,38 @@ type LineItemBatchUpdateOptions struct {
 
 type LineItemBatchUpdateProperties struct {
 	Id         string             `json:"id"`
+	IdProperty string             `json:"idProperty,omitempty"`
 	Properties LineItemProperties `json:"properties"`
 }
 
+// LineItemBatchUpsertOptions creates or updates the line items whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type LineItemBatchUpsertOptions struct {
+	IdProperty string                          `json:"-"`
+	Inputs     []LineItemBatchUpdateProperties `json:"inputs"`
+}
+
+func (o LineItemBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options LineItemBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *LineItemBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type LineItemBatchUpsertOutput struct {
+	Status      string                 `json:"status"`
+	Results     []LineItemUpsertResult `json:"results"`
+	RequestedAt string                 `json:"requestedAt"`
+	StartedAt   string                 `json:"startedAt"`
+	CompletedAt string                 `json:"completedAt"`
+	BatchErrors
+}
+
+// LineItemUpsertResult is an upserted line item. New is true when it was created and false when it was updated.
+type LineItemUpsertResult struct {
+	LineItem
+	New bool `json:"new"`
+}
+
 type LineItemSearchOptions struct {
 	SearchOptions
 }
@@ -229,59 +263,39 @@ func (z *lineItems) BatchArchive(ctx context.Context, lineItemIds []string) erro
 		options.Inputs = append(options.Inputs, BatchInput{Id: lineItemId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *lineItems) BatchCreate(ctx context.Context, options *LineItemBatchCreateOptions) (*LineItemBatchOutput, error) {
 	u := "/crm/v3/objects/line_items/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	lineItems := &LineItemBatchOutput{}
 
-	err = z.client.do(req, lineItems)
-	if err != nil {
-		return nil, err
-	}
-	return lineItems, nil
+	err := z.client.doBatch(ctx, u, options, lineItems)
+	return batchResult(lineItems, err)
 }
 
 func (z *lineItems) BatchRead(ctx context.Context, options *LineItemBatchReadOptions) (*LineItemBatchOutput, error) {
 	u := "/crm/v3/objects/line_items/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	lbrr := &LineItemBatchOutput{}
 
-	err = z.client.do(req, lbrr)
-	if err != nil {
-		return nil, err
-	}
-	return lbrr, nil
+	err := z.client.doBatch(ctx, u, options, lbrr)
+	return batchResult(lbrr, err)
 }
 
 func (z *lineItems) BatchUpdate(ctx context.Context, options *LineItemBatchUpdateOptions) (*LineItemBatchOutput, error) {
 	u := "/crm/v3/objects/line_items/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	li := &LineItemBatchOutput{}
 
-	err = z.client.do(req, li)
-	if err != nil {
-		return nil, err
-	}
-	return li, nil
+	err := z.client.doBatch(ctx, u, options, li)
+	return batchResult(li, err)
+}
+
+func (z *lineItems) BatchUpsert(ctx context.Context, options *LineItemBatchUpsertOptions) (*LineItemBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/line_items/batch/upsert"
+	li := &LineItemBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, li)
+	return batchResult(li, err)
 }
 
 func (z *lineItems) Search(ctx context.Context, options *LineItemSearchOptions) (*LineItemSearchResults, error) {
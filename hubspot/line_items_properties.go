@@ -1,59 +1,71 @@
 package hubspot
 
 type LineItemProperties struct {
-	Amount                                string `json:"amount,omitempty"`
-	Createdate                            string `json:"createdate,omitempty"`
-	Description                           string `json:"description,omitempty"`
-	Discount                              string `json:"discount,omitempty"`
-	HsAcv                                 string `json:"hs_acv,omitempty"`
-	HsAllAccessibleTeamIds                string `json:"hs_all_accessible_team_ids,omitempty"`
-	HsAllAssignedBusinessUnitIds          string `json:"hs_all_assigned_business_unit_ids,omitempty"`
-	HsAllOwnerIds                         string `json:"hs_all_owner_ids,omitempty"`
-	HsAllTeamIds                          string `json:"hs_all_team_ids,omitempty"`
-	HsAllowBuyerSelectedQuantity          string `json:"hs_allow_buyer_selected_quantity,omitempty"`
-	HsArr                                 string `json:"hs_arr,omitempty"`
-	HsCostOfGoodsSold                     string `json:"hs_cost_of_goods_sold,omitempty"`
-	HsCreatedByUserId                     string `json:"hs_created_by_user_id,omitempty"`
-	HsCreatedate                          string `json:"hs_createdate,omitempty"`
-	HsDiscountPercentage                  string `json:"hs_discount_percentage,omitempty"`
-	HsExternalId                          string `json:"hs_external_id,omitempty"`
-	HsImages                              string `json:"hs_images,omitempty"`
-	HsLastmodifieddate                    string `json:"hs_lastmodifieddate,omitempty"`
-	HsLineItemCurrencyCode                string `json:"hs_line_item_currency_code,omitempty"`
-	HsMargin                              string `json:"hs_margin,omitempty"`
-	HsMarginAcv                           string `json:"hs_margin_acv,omitempty"`
-	HsMarginArr                           string `json:"hs_margin_arr,omitempty"`
-	HsMarginMrr                           string `json:"hs_margin_mrr,omitempty"`
-	HsMarginTcv                           string `json:"hs_margin_tcv,omitempty"`
-	HsMergedObjectIds                     string `json:"hs_merged_object_ids,omitempty"`
-	HsMrr                                 string `json:"hs_mrr,omitempty"`
-	HsObjectId                            string `json:"hs_object_id,omitempty"`
-	HsPositionOnQuote                     string `json:"hs_position_on_quote,omitempty"`
-	HsPreDiscountAmount                   string `json:"hs_pre_discount_amount,omitempty"`
-	HsProductId                           string `json:"hs_product_id,omitempty"`
-	HsRecurringBillingEndDate             string `json:"hs_recurring_billing_end_date,omitempty"`
-	HsRecurringBillingNumberOfPayments    string `json:"hs_recurring_billing_string_of_payments,omitempty"`
-	HsRecurringBillingPeriod              string `json:"hs_recurring_billing_period,omitempty"`
-	HsRecurringBillingStartDate           string `json:"hs_recurring_billing_start_date,omitempty"`
-	HsRecurringBillingTerms               string `json:"hs_recurring_billing_terms,omitempty"`
-	HsSku                                 string `json:"hs_sku,omitempty"`
-	HsSyncAmount                          string `json:"hs_sync_amount,omitempty"`
-	HsTcv                                 string `json:"hs_tcv,omitempty"`
-	HsTermInMonths                        string `json:"hs_term_in_months,omitempty"`
-	HsTotalDiscount                       string `json:"hs_total_discount,omitempty"`
-	HsUniqueCreationKey                   string `json:"hs_unique_creation_key,omitempty"`
-	HsUpdatedByUserId                     string `json:"hs_updated_by_user_id,omitempty"`
-	HsUrl                                 string `json:"hs_url,omitempty"`
-	HsUserIdsOfAllNotificationFollowers   string `json:"hs_user_ids_of_all_notification_followers,omitempty"`
-	HsUserIdsOfAllNotificationUnfollowers string `json:"hs_user_ids_of_all_notification_unfollowers,omitempty"`
-	HsUserIdsOfAllOwners                  string `json:"hs_user_ids_of_all_owners,omitempty"`
-	HsVariantId                           string `json:"hs_variant_id,omitempty"`
-	HubspotOwnerAssigneddate              string `json:"hubspot_owner_assigneddate,omitempty"`
-	HubspotOwnerId                        string `json:"hubspot_owner_id,omitempty"`
-	HubspotTeamId                         string `json:"hubspot_team_id,omitempty"`
-	Name                                  string `json:"name,omitempty"`
-	Price                                 string `json:"price,omitempty"`
-	Quantity                              string `json:"quantity,omitempty"`
-	Recurringbillingfrequency             string `json:"recurringbillingfrequency,omitempty"`
-	Tax                                   string `json:"tax,omitempty"`
+	Amount                                Number   `json:"amount,omitempty"`
+	Createdate                            DateTime `json:"createdate,omitempty"`
+	Description                           string   `json:"description,omitempty"`
+	Discount                              string   `json:"discount,omitempty"`
+	HsAcv                                 string   `json:"hs_acv,omitempty"`
+	HsAllAccessibleTeamIds                string   `json:"hs_all_accessible_team_ids,omitempty"`
+	HsAllAssignedBusinessUnitIds          string   `json:"hs_all_assigned_business_unit_ids,omitempty"`
+	HsAllOwnerIds                         string   `json:"hs_all_owner_ids,omitempty"`
+	HsAllTeamIds                          string   `json:"hs_all_team_ids,omitempty"`
+	HsAllowBuyerSelectedQuantity          string   `json:"hs_allow_buyer_selected_quantity,omitempty"`
+	HsArr                                 string   `json:"hs_arr,omitempty"`
+	HsCostOfGoodsSold                     string   `json:"hs_cost_of_goods_sold,omitempty"`
+	HsCreatedByUserId                     string   `json:"hs_created_by_user_id,omitempty"`
+	HsCreatedate                          DateTime `json:"hs_createdate,omitempty"`
+	HsDiscountPercentage                  string   `json:"hs_discount_percentage,omitempty"`
+	HsExternalId                          string   `json:"hs_external_id,omitempty"`
+	HsImages                              string   `json:"hs_images,omitempty"`
+	HsLastmodifieddate                    DateTime `json:"hs_lastmodifieddate,omitempty"`
+	HsLineItemCurrencyCode                string   `json:"hs_line_item_currency_code,omitempty"`
+	HsMargin                              string   `json:"hs_margin,omitempty"`
+	HsMarginAcv                           string   `json:"hs_margin_acv,omitempty"`
+	HsMarginArr                           string   `json:"hs_margin_arr,omitempty"`
+	HsMarginMrr                           string   `json:"hs_margin_mrr,omitempty"`
+	HsMarginTcv                           string   `json:"hs_margin_tcv,omitempty"`
+	HsMergedObjectIds                     string   `json:"hs_merged_object_ids,omitempty"`
+	HsMrr                                 string   `json:"hs_mrr,omitempty"`
+	HsObjectId                            string   `json:"hs_object_id,omitempty"`
+	HsPositionOnQuote                     string   `json:"hs_position_on_quote,omitempty"`
+	HsPreDiscountAmount                   string   `json:"hs_pre_discount_amount,omitempty"`
+	HsProductId                           string   `json:"hs_product_id,omitempty"`
+	HsRecurringBillingEndDate             string   `json:"hs_recurring_billing_end_date,omitempty"`
+	HsRecurringBillingNumberOfPayments    string   `json:"hs_recurring_billing_string_of_payments,omitempty"`
+	HsRecurringBillingPeriod              string   `json:"hs_recurring_billing_period,omitempty"`
+	HsRecurringBillingStartDate           string   `json:"hs_recurring_billing_start_date,omitempty"`
+	HsRecurringBillingTerms               string   `json:"hs_recurring_billing_terms,omitempty"`
+	HsSku                                 string   `json:"hs_sku,omitempty"`
+	HsSyncAmount                          string   `json:"hs_sync_amount,omitempty"`
+	HsTcv                                 string   `json:"hs_tcv,omitempty"`
+	HsTermInMonths                        string   `json:"hs_term_in_months,omitempty"`
+	HsTotalDiscount                       string   `json:"hs_total_discount,omitempty"`
+	HsUniqueCreationKey                   string   `json:"hs_unique_creation_key,omitempty"`
+	HsUpdatedByUserId                     string   `json:"hs_updated_by_user_id,omitempty"`
+	HsUrl                                 string   `json:"hs_url,omitempty"`
+	HsUserIdsOfAllNotificationFollowers   string   `json:"hs_user_ids_of_all_notification_followers,omitempty"`
+	HsUserIdsOfAllNotificationUnfollowers string   `json:"hs_user_ids_of_all_notification_unfollowers,omitempty"`
+	HsUserIdsOfAllOwners                  string   `json:"hs_user_ids_of_all_owners,omitempty"`
+	HsVariantId                           string   `json:"hs_variant_id,omitempty"`
+	HubspotOwnerAssigneddate              string   `json:"hubspot_owner_assigneddate,omitempty"`
+	HubspotOwnerId                        string   `json:"hubspot_owner_id,omitempty"`
+	HubspotTeamId                         string   `json:"hubspot_team_id,omitempty"`
+	Name                                  string   `json:"name,omitempty"`
+	Price                                 Number   `json:"price,omitempty"`
+	Quantity                              Number   `json:"quantity,omitempty"`
+	Recurringbillingfrequency             string   `json:"recurringbillingfrequency,omitempty"`
+	Tax                                   string   `json:"tax,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p LineItemProperties) MarshalJSON() ([]byte, error) {
+	type properties LineItemProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *LineItemProperties) UnmarshalJSON(b []byte) error {
+	type properties LineItemProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
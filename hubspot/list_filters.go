@@ -0,0 +1,115 @@
+package hubspot
+
+// ListFilterBranchType is the kind of a ListFilterBranch.
+type ListFilterBranchType string
+
+const (
+	ListFilterBranchTypeOr            ListFilterBranchType = "OR"
+	ListFilterBranchTypeAnd           ListFilterBranchType = "AND"
+	ListFilterBranchTypeNotAll        ListFilterBranchType = "NOT_ALL"
+	ListFilterBranchTypeNotAny        ListFilterBranchType = "NOT_ANY"
+	ListFilterBranchTypeAssociation   ListFilterBranchType = "ASSOCIATION"
+	ListFilterBranchTypeUnifiedEvents ListFilterBranchType = "UNIFIED_EVENTS"
+)
+
+// ListFilterType is the kind of a ListFilter.
+type ListFilterType string
+
+const (
+	ListFilterTypeProperty       ListFilterType = "PROPERTY"
+	ListFilterTypeAssociation    ListFilterType = "ASSOCIATION"
+	ListFilterTypeInList         ListFilterType = "IN_LIST"
+	ListFilterTypeConstant       ListFilterType = "CONSTANT"
+	ListFilterTypeFormSubmission ListFilterType = "FORM_SUBMISSION"
+	ListFilterTypeEmailEvent     ListFilterType = "EMAIL_EVENT"
+	ListFilterTypePageView       ListFilterType = "PAGE_VIEW"
+)
+
+// ListOperationType is the type of value a ListFilterOperation compares against.
+type ListOperationType string
+
+const (
+	ListOperationTypeString      ListOperationType = "STRING"
+	ListOperationTypeMultiString ListOperationType = "MULTISTRING"
+	ListOperationTypeNumber      ListOperationType = "NUMBER"
+	ListOperationTypeBool        ListOperationType = "BOOL"
+	ListOperationTypeEnumeration ListOperationType = "ENUMERATION"
+	ListOperationTypeAllProperty ListOperationType = "ALL_PROPERTY"
+	ListOperationTypeTimePoint   ListOperationType = "TIME_POINT"
+	ListOperationTypeTimeRanged  ListOperationType = "TIME_RANGED"
+)
+
+// ListFilterBranch is a node of the filter tree of a DYNAMIC or SNAPSHOT list. Records match an AND
+// branch when they pass all of its filters and child branches, and an OR branch when they pass any.
+// HubSpot expects the root to be an OR branch whose children are AND branches.
+type ListFilterBranch struct {
+	FilterBranchType     ListFilterBranchType `json:"filterBranchType"`
+	FilterBranchOperator string               `json:"filterBranchOperator,omitempty"`
+	FilterBranches       []ListFilterBranch   `json:"filterBranches"`
+	Filters              []ListFilter         `json:"filters"`
+
+	// ObjectTypeId, AssociationTypeId and AssociationCategory are set on ASSOCIATION branches.
+	ObjectTypeId        ObjectType `json:"objectTypeId,omitempty"`
+	AssociationTypeId   int        `json:"associationTypeId,omitempty"`
+	AssociationCategory string     `json:"associationCategory,omitempty"`
+	Operator            string     `json:"operator,omitempty"`
+}
+
+type ListFilter struct {
+	FilterType ListFilterType       `json:"filterType"`
+	Property   string               `json:"property,omitempty"`
+	Operation  *ListFilterOperation `json:"operation,omitempty"`
+
+	// ListId and Operator are set on IN_LIST filters, Operator being IN_LIST or NOT_IN_LIST.
+	ListId   string `json:"listId,omitempty"`
+	Operator string `json:"operator,omitempty"`
+}
+
+// ListFilterOperation compares a property against Value or Values using Operator, e.g. IS_EQUAL_TO,
+// IS_NOT_EQUAL_TO, CONTAINS, IS_ANY_OF, IS_GREATER_THAN, IS_KNOWN or IS_UNKNOWN.
+type ListFilterOperation struct {
+	OperationType                ListOperationType `json:"operationType"`
+	Operator                     string            `json:"operator"`
+	Value                        interface{}       `json:"value,omitempty"`
+	Values                       []string          `json:"values,omitempty"`
+	IncludeObjectsWithNoValueSet bool              `json:"includeObjectsWithNoValueSet"`
+}
+
+// NewListFilterBranch returns the root OR branch with one AND branch per group of filters, so that a
+// record matches when it passes every filter of at least one group.
+func NewListFilterBranch(groups ...[]ListFilter) *ListFilterBranch {
+	root := &ListFilterBranch{
+		FilterBranchType: ListFilterBranchTypeOr,
+		FilterBranches:   make([]ListFilterBranch, 0, len(groups)),
+		Filters:          make([]ListFilter, 0),
+	}
+	for _, filters := range groups {
+		if filters == nil {
+			filters = make([]ListFilter, 0)
+		}
+		root.FilterBranches = append(root.FilterBranches, ListFilterBranch{
+			FilterBranchType: ListFilterBranchTypeAnd,
+			FilterBranches:   make([]ListFilterBranch, 0),
+			Filters:          filters,
+		})
+	}
+	return root
+}
+
+// NewListPropertyFilter returns a PROPERTY filter applying operation to property.
+func NewListPropertyFilter(property string, operation ListFilterOperation) ListFilter {
+	return ListFilter{
+		FilterType: ListFilterTypeProperty,
+		Property:   property,
+		Operation:  &operation,
+	}
+}
+
+// NewListInListFilter returns a filter matching the members of listId.
+func NewListInListFilter(listId string) ListFilter {
+	return ListFilter{
+		FilterType: ListFilterTypeInList,
+		ListId:     listId,
+		Operator:   "IN_LIST",
+	}
+}
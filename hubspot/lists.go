@@ -0,0 +1,385 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// MaxListMembershipChanges is the number of record ids HubSpot accepts in a single membership change.
+// AddMembers and RemoveMembers split larger changes into several requests, see PartialBatchError.
+const MaxListMembershipChanges = 100000
+
+type Lists interface {
+	Create(ctx context.Context, options *ListCreateOptions) (*List, error)
+	Read(ctx context.Context, listId string, query *ListReadQuery) (*List, error)
+	ReadByName(ctx context.Context, objectType ObjectType, listName string, query *ListReadQuery) (*List, error)
+	Search(ctx context.Context, options *ListSearchOptions) (*ListSearchResults, error)
+	Rename(ctx context.Context, listId string, listName string) (*List, error)
+	UpdateFilters(ctx context.Context, listId string, filterBranch *ListFilterBranch) (*List, error)
+	Delete(ctx context.Context, listId string) error
+	Restore(ctx context.Context, listId string) error
+	AddMembers(ctx context.Context, listId string, recordIds []string) (*ListMembershipChange, error)
+	RemoveMembers(ctx context.Context, listId string, recordIds []string) (*ListMembershipChange, error)
+	RemoveAllMembers(ctx context.Context, listId string) error
+	ListMemberships(ctx context.Context, listId string, query *ListMembershipQuery) (*ListMembershipList, error)
+	ListRecordMemberships(ctx context.Context, objectType ObjectType, recordId string) (*ListRecordMembershipList, error)
+}
+
+type lists struct {
+	client *Client
+}
+
+// ListProcessingType decides how the members of a list are maintained. MANUAL lists only change through
+// AddMembers and RemoveMembers, DYNAMIC lists follow their filters and SNAPSHOT lists are populated
+// from their filters once and then behave like MANUAL lists.
+type ListProcessingType string
+
+const (
+	ListProcessingTypeManual   ListProcessingType = "MANUAL"
+	ListProcessingTypeDynamic  ListProcessingType = "DYNAMIC"
+	ListProcessingTypeSnapshot ListProcessingType = "SNAPSHOT"
+)
+
+type List struct {
+	ListId               string             `json:"listId"`
+	ListVersion          int                `json:"listVersion"`
+	Name                 string             `json:"name"`
+	ObjectTypeId         ObjectType         `json:"objectTypeId"`
+	ProcessingType       ListProcessingType `json:"processingType"`
+	ProcessingStatus     string             `json:"processingStatus"`
+	FilterBranch         *ListFilterBranch  `json:"filterBranch,omitempty"`
+	FiltersUpdatedAt     string             `json:"filtersUpdatedAt,omitempty"`
+	CreatedAt            string             `json:"createdAt,omitempty"`
+	CreatedById          string             `json:"createdById,omitempty"`
+	UpdatedAt            string             `json:"updatedAt,omitempty"`
+	UpdatedById          string             `json:"updatedById,omitempty"`
+	DeletedAt            string             `json:"deletedAt,omitempty"`
+	AdditionalProperties map[string]string  `json:"additionalProperties,omitempty"`
+}
+
+// ListCreateOptions creates a list of ObjectTypeId records. FilterBranch is required for DYNAMIC and
+// SNAPSHOT lists, see NewListFilterBranch.
+type ListCreateOptions struct {
+	Name             string             `json:"name"`
+	ObjectTypeId     ObjectType         `json:"objectTypeId"`
+	ProcessingType   ListProcessingType `json:"processingType"`
+	FilterBranch     *ListFilterBranch  `json:"filterBranch,omitempty"`
+	ListFolderId     int64              `json:"listFolderId,omitempty"`
+	CustomProperties map[string]string  `json:"customProperties,omitempty"`
+}
+
+type ListReadQuery struct {
+	IncludeFilters bool `url:"includeFilters,omitempty"`
+}
+
+type ListSearchOptions struct {
+	Query                string               `json:"query,omitempty"`
+	ListIds              []string             `json:"listIds,omitempty"`
+	ProcessingTypes      []ListProcessingType `json:"processingTypes,omitempty"`
+	AdditionalProperties []string             `json:"additionalProperties,omitempty"`
+	Offset               int                  `json:"offset,omitempty"`
+	Count                int                  `json:"count,omitempty"`
+}
+
+type ListSearchResults struct {
+	Lists   []List `json:"lists"`
+	HasMore bool   `json:"hasMore"`
+	Offset  int    `json:"offset"`
+	Total   int    `json:"total"`
+}
+
+// listResponse wraps the list returned by the create, read and update endpoints.
+type listResponse struct {
+	List        List `json:"list"`
+	UpdatedList List `json:"updatedList"`
+}
+
+// ListMembershipChange holds the record ids a membership change applied to. RecordIdsMissing lists the
+// ids which do not exist or were not members.
+type ListMembershipChange struct {
+	RecordIdsAdded   []string `json:"recordIdsAdded,omitempty"`
+	RecordIdsRemoved []string `json:"recordIdsRemoved,omitempty"`
+	RecordIdsMissing []string `json:"recordIdsMissing,omitempty"`
+}
+
+type listRenameQuery struct {
+	ListName string `url:"listName"`
+}
+
+type ListMembershipQuery struct {
+	After  string `url:"after,omitempty"`
+	Before string `url:"before,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+}
+
+type ListMembershipList struct {
+	Results []ListMembership `json:"results"`
+	Pagination
+}
+
+type ListMembership struct {
+	RecordId            string `json:"recordId"`
+	MembershipTimestamp string `json:"membershipTimestamp"`
+}
+
+type ListRecordMembershipList struct {
+	Results []ListRecordMembership `json:"results"`
+	Total   int                    `json:"total"`
+}
+
+// ListRecordMembership is a list a record is a member of.
+type ListRecordMembership struct {
+	ListId              string `json:"listId"`
+	ListVersion         int    `json:"listVersion"`
+	FirstAddedTimestamp string `json:"firstAddedTimestamp"`
+	LastAddedTimestamp  string `json:"lastAddedTimestamp"`
+}
+
+func (z *lists) Create(ctx context.Context, options *ListCreateOptions) (*List, error) {
+	u := "/crm/v3/lists"
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &listResponse{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.List, nil
+}
+
+func (z *lists) Read(ctx context.Context, listId string, query *ListReadQuery) (*List, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s", listId)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &listResponse{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.List, nil
+}
+
+func (z *lists) ReadByName(ctx context.Context, objectType ObjectType, listName string, query *ListReadQuery) (*List, error) {
+	u := fmt.Sprintf("/crm/v3/lists/object-type-id/%s/name/%s", objectType.Id(), url.PathEscape(listName))
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &listResponse{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.List, nil
+}
+
+func (z *lists) Search(ctx context.Context, options *ListSearchOptions) (*ListSearchResults, error) {
+	u := "/crm/v3/lists/search"
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &ListSearchResults{}
+
+	err = z.client.do(req, results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (z *lists) Rename(ctx context.Context, listId string, listName string) (*List, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s/update-list-name", listId)
+	query := &listRenameQuery{ListName: listName}
+	req, err := z.client.newHttpRequestWithQuery(ctx, "PUT", u, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &listResponse{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.UpdatedList, nil
+}
+
+func (z *lists) UpdateFilters(ctx context.Context, listId string, filterBranch *ListFilterBranch) (*List, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s/update-list-filters", listId)
+	options := map[string]*ListFilterBranch{"filterBranch": filterBranch}
+	req, err := z.client.newHttpRequest(ctx, "PUT", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &listResponse{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.UpdatedList, nil
+}
+
+func (z *lists) Delete(ctx context.Context, listId string) error {
+	u := fmt.Sprintf("/crm/v3/lists/%s", listId)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return z.client.do(req, nil)
+}
+
+func (z *lists) Restore(ctx context.Context, listId string) error {
+	u := fmt.Sprintf("/crm/v3/lists/%s/restore", listId)
+	req, err := z.client.newHttpRequest(ctx, "PUT", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return z.client.do(req, nil)
+}
+
+func (z *lists) AddMembers(ctx context.Context, listId string, recordIds []string) (*ListMembershipChange, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s/memberships/add", listId)
+	return z.changeMembers(ctx, u, recordIds)
+}
+
+func (z *lists) RemoveMembers(ctx context.Context, listId string, recordIds []string) (*ListMembershipChange, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s/memberships/remove", listId)
+	return z.changeMembers(ctx, u, recordIds)
+}
+
+// changeMembers sends recordIds to a membership endpoint in chunks of MaxListMembershipChanges. It fails like
+// the Batch methods: a failed chunk does not stop the later ones, and when some chunks failed the error is a
+// *PartialBatchError listing the record ids which were not applied, see PartialBatchError.
+func (z *lists) changeMembers(ctx context.Context, u string, recordIds []string) (*ListMembershipChange, error) {
+	change := &ListMembershipChange{}
+	partial := &PartialBatchError{}
+	for start := 0; start < len(recordIds); start += MaxListMembershipChanges {
+		end := min(start+MaxListMembershipChanges, len(recordIds))
+		res, err := z.changeMembersChunk(ctx, u, recordIds[start:end])
+		if err != nil {
+			partial.Failed = append(partial.Failed, failedMembershipChunk(recordIds, start, end, err))
+			continue
+		}
+		partial.Succeeded++
+		change.RecordIdsAdded = append(change.RecordIdsAdded, res.RecordIdsAdded...)
+		change.RecordIdsRemoved = append(change.RecordIdsRemoved, res.RecordIdsRemoved...)
+		change.RecordIdsMissing = append(change.RecordIdsMissing, res.RecordIdsMissing...)
+	}
+
+	if len(partial.Failed) == 0 {
+		return change, nil
+	}
+	if partial.Succeeded == 0 {
+		if len(partial.Failed) == 1 {
+			return nil, partial.Failed[0].Err
+		}
+		return nil, partial
+	}
+	return change, partial
+}
+
+func (z *lists) changeMembersChunk(ctx context.Context, u string, recordIds []string) (*ListMembershipChange, error) {
+	req, err := z.client.newHttpRequest(ctx, "PUT", u, recordIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListMembershipChange{}
+
+	err = z.client.do(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func failedMembershipChunk(recordIds []string, start int, end int, err error) FailedBatch {
+	failed := FailedBatch{Ids: recordIds[start:end:end], Err: err}
+	for i := start; i < end; i++ {
+		failed.Indexes = append(failed.Indexes, i)
+	}
+	return failed
+}
+
+func (z *lists) RemoveAllMembers(ctx context.Context, listId string) error {
+	u := fmt.Sprintf("/crm/v3/lists/%s/memberships", listId)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return z.client.do(req, nil)
+}
+
+func (z *lists) ListMemberships(ctx context.Context, listId string, query *ListMembershipQuery) (*ListMembershipList, error) {
+	u := fmt.Sprintf("/crm/v3/lists/%s/memberships", listId)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := &ListMembershipList{}
+
+	err = z.client.do(req, memberships)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+// ListRecordMemberships returns the lists a record is a member of, e.g. for a contact:
+//
+//	client.Lists.ListRecordMemberships(ctx, hubspot.ObjectTypeContacts, contactId)
+func (z *lists) ListRecordMemberships(ctx context.Context, objectType ObjectType, recordId string) (*ListRecordMembershipList, error) {
+	u := fmt.Sprintf("/crm/v3/lists/records/%s/%s/memberships", objectType.Id(), recordId)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := &ListRecordMembershipList{}
+
+	err = z.client.do(req, memberships)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+// ListMembershipPager walks every member of a list, starting from the cursor in query if any.
+func ListMembershipPager(client *Client, listId string, query *ListMembershipQuery) *Pager[ListMembership] {
+	q := ListMembershipQuery{}
+	if query != nil {
+		q = *query
+	}
+	return PagerOf(func(ctx context.Context, after string) (*ListMembershipList, error) {
+		q.After = after
+		return client.Lists.ListMemberships(ctx, listId, &q)
+	}, func(l *ListMembershipList) []ListMembership { return l.Results }).StartAfter(q.After)
+}
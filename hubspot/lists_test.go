@@ -0,0 +1,195 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// membershipServer adds the record ids of every membership change, failing the requests whose first
+// record id is in fail. It records the number of record ids of each request.
+type membershipServer struct {
+	fail  map[string]bool
+	mu    sync.Mutex
+	sizes []int
+}
+
+func (s *membershipServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" || r.URL.Path != "/crm/v3/lists/1/memberships/add" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var recordIds []string
+	json.NewDecoder(r.Body).Decode(&recordIds)
+	s.mu.Lock()
+	s.sizes = append(s.sizes, len(recordIds))
+	s.mu.Unlock()
+
+	if len(recordIds) > MaxListMembershipChanges {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if s.fail[recordIds[0]] {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"error","message":"invalid record","category":"VALIDATION_ERROR"}`))
+		return
+	}
+	json.NewEncoder(w).Encode(ListMembershipChange{RecordIdsAdded: recordIds})
+}
+
+func recordIds(n int) []string {
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	return ids
+}
+
+func TestAddMembersSplitsLargeChanges(t *testing.T) {
+	s := &membershipServer{}
+	client := newTestClient(t, s.handle)
+
+	ids := recordIds(2*MaxListMembershipChanges + 1)
+	change, err := client.Lists.AddMembers(context.Background(), "1", ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{MaxListMembershipChanges, MaxListMembershipChanges, 1}; !reflect.DeepEqual(s.sizes, want) {
+		t.Errorf("request sizes = %v, want %v", s.sizes, want)
+	}
+	if !reflect.DeepEqual(change.RecordIdsAdded, ids) {
+		t.Errorf("got %d added record ids, want %d in order", len(change.RecordIdsAdded), len(ids))
+	}
+}
+
+func TestAddMembersPartialFailure(t *testing.T) {
+	ids := recordIds(2*MaxListMembershipChanges + 1)
+	s := &membershipServer{fail: map[string]bool{ids[MaxListMembershipChanges]: true}}
+	client := newTestClient(t, s.handle)
+
+	change, err := client.Lists.AddMembers(context.Background(), "1", ids)
+	var partial *PartialBatchError
+	if !errors.As(err, &partial) {
+		t.Fatalf("err = %v, want a *PartialBatchError", err)
+	}
+	if len(s.sizes) != 3 {
+		t.Errorf("sent %d requests, want 3: a failed chunk must not stop the later ones", len(s.sizes))
+	}
+	if partial.Succeeded != 2 || len(partial.Failed) != 1 {
+		t.Fatalf("succeeded %d, failed %d, want 2 and 1", partial.Succeeded, len(partial.Failed))
+	}
+	failed := ids[MaxListMembershipChanges : 2*MaxListMembershipChanges]
+	if !reflect.DeepEqual(partial.FailedIds(), failed) {
+		t.Errorf("got %d failed ids, want the %d ids of the second chunk", len(partial.FailedIds()), len(failed))
+	}
+	if indexes := partial.FailedIndexes(); indexes[0] != MaxListMembershipChanges || len(indexes) != MaxListMembershipChanges {
+		t.Errorf("failed indexes start at %d with %d entries", indexes[0], len(indexes))
+	}
+	if !IsValidationError(err) {
+		t.Errorf("err does not unwrap to the validation error: %v", err)
+	}
+
+	want := append(append([]string{}, ids[:MaxListMembershipChanges]...), ids[2*MaxListMembershipChanges:]...)
+	if change == nil || !reflect.DeepEqual(change.RecordIdsAdded, want) {
+		t.Errorf("change does not hold the record ids of the applied chunks")
+	}
+}
+
+func TestAddMembersFailure(t *testing.T) {
+	s := &membershipServer{fail: map[string]bool{"0": true}}
+	client := newTestClient(t, s.handle)
+
+	change, err := client.Lists.AddMembers(context.Background(), "1", recordIds(10))
+	if change != nil {
+		t.Errorf("change = %+v, want nil when nothing was applied", change)
+	}
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) {
+		t.Fatalf("err = %v, want the *ErrorResponse of the request", err)
+	}
+	var partial *PartialBatchError
+	if errors.As(err, &partial) {
+		t.Errorf("a single request failed with a *PartialBatchError")
+	}
+}
+
+func TestRenameSendsListNameQuery(t *testing.T) {
+	var method, rawQuery string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, rawQuery = r.Method, r.URL.RawQuery
+		w.Write([]byte(`{"updatedList":{"listId":"1","name":"Q1 & Q2 leads"}}`))
+	})
+
+	list, err := client.Lists.Rename(context.Background(), "1", "Q1 & Q2 leads")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != "PUT" {
+		t.Errorf("method = %s, want PUT", method)
+	}
+	if rawQuery != "listName=Q1+%26+Q2+leads" {
+		t.Errorf("query = %q, want the escaped list name", rawQuery)
+	}
+	if list.Name != "Q1 & Q2 leads" {
+		t.Errorf("name = %q, want the updated list", list.Name)
+	}
+}
+
+func TestListMembershipPager(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"results":[{"recordId":"1"},{"recordId":"2"}],"paging":{"next":{"after":"a"}}}`,
+		"a": `{"results":[{"recordId":"3"},{"recordId":"4"}],"paging":{"next":{"after":"b"}}}`,
+		"b": `{"results":[{"recordId":"5"}]}`,
+	}
+	var limits []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/crm/v3/lists/1/memberships" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		limits = append(limits, r.URL.Query().Get("limit"))
+		w.Write([]byte(pages[r.URL.Query().Get("after")]))
+	})
+
+	members, err := ListMembershipPager(client, "1", &ListMembershipQuery{Limit: 2}).CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range members {
+		got = append(got, m.RecordId)
+	}
+	if want := []string{"1", "2", "3", "4", "5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("record ids = %v, want %v", got, want)
+	}
+	if want := []string{"2", "2", "2"}; !reflect.DeepEqual(limits, want) {
+		t.Errorf("limits = %v, want the query limit on every page", limits)
+	}
+}
+
+func TestNewListFilterBranchJSON(t *testing.T) {
+	branch := NewListFilterBranch(
+		[]ListFilter{NewListPropertyFilter("lifecyclestage", ListFilterOperation{
+			OperationType: ListOperationTypeEnumeration,
+			Operator:      "IS_ANY_OF",
+			Values:        []string{"lead"},
+		})},
+		nil,
+	)
+	got, err := json.Marshal(branch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"filterBranchType":"OR","filterBranches":[` +
+		`{"filterBranchType":"AND","filterBranches":[],"filters":[{"filterType":"PROPERTY","property":"lifecyclestage",` +
+		`"operation":{"operationType":"ENUMERATION","operator":"IS_ANY_OF","values":["lead"],"includeObjectsWithNoValueSet":false}}]},` +
+		`{"filterBranchType":"AND","filterBranches":[],"filters":[]}],"filters":[]}`
+	if string(got) != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
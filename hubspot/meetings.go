@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Meetings interface {
 	BatchCreate(ctx context.Context, options *MeetingBatchCreateOptions) (*MeetingBatchOutput, error)
 	BatchRead(ctx context.Context, options *MeetingBatchReadOptions) (*MeetingBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *MeetingBatchUpdateOptions) (*MeetingBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *MeetingBatchUpsertOptions) (*MeetingBatchUpsertOutput, error)
 	Search(ctx context.Context, options *MeetingSearchOptions) (*MeetingSearchResults, error)
 	Merge(ctx context.Context, options *MeetingMergeOptions) (*Meeting, error)
 }
@@ -50,11 +52,13 @@ type MeetingList struct {
 }
 
 type Meeting struct {
-	Id         string            `json:"id"`
-	Properties MeetingProperties `json:"properties"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  string            `json:"updatedAt"`
-	Archived   bool              `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            MeetingProperties  `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type MeetingProperties struct {
@@ -70,6 +74,36 @@ type MeetingProperties struct {
 	HsMeetingTitle         string `json:"hs_meeting_title,omitempty"`
 	HsTimestamp            string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId         string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p MeetingProperties) MarshalJSON() ([]byte, error) {
+	type properties MeetingProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *MeetingProperties) UnmarshalJSON(b []byte) error {
+	type properties MeetingProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+}
+
+// CreateOrUpdateProperties returns the writable properties of p, including its custom properties in Raw,
+// so that a read meeting can be modified and passed to Update without losing them.
+func (p MeetingProperties) CreateOrUpdateProperties() MeetingCreateOrUpdateProperties {
+	return MeetingCreateOrUpdateProperties{
+		HsInternalMeetingNotes: p.HsInternalMeetingNotes,
+		HsMeetingBody:          p.HsMeetingBody,
+		HsMeetingEndTime:       p.HsMeetingEndTime,
+		HsMeetingExternalUrl:   p.HsMeetingExternalUrl,
+		HsMeetingLocation:      p.HsMeetingLocation,
+		HsMeetingOutcome:       p.HsMeetingOutcome,
+		HsMeetingStartTime:     p.HsMeetingStartTime,
+		HsMeetingTitle:         p.HsMeetingTitle,
+		HsTimestamp:            p.HsTimestamp,
+		HubSpotOwnerId:         p.HubSpotOwnerId,
+		Raw:                    writableRawProperties(p.Raw),
+	}
 }
 
 type MeetingCreateOrUpdateOptions struct {
@@ -87,6 +121,18 @@ type MeetingCreateOrUpdateProperties struct {
 	HsMeetingTitle         string `json:"hs_meeting_title,omitempty"`
 	HsTimestamp            string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId         string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p MeetingCreateOrUpdateProperties) MarshalJSON() ([]byte, error) {
+	type properties MeetingCreateOrUpdateProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *MeetingCreateOrUpdateProperties) UnmarshalJSON(b []byte) error {
+	type properties MeetingCreateOrUpdateProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type MeetingReadQuery struct {
@@ -103,6 +149,7 @@ type MeetingBatchOutput struct {
 	RequestedAt string    `json:"requestedAt"`
 	StartedAt   string    `json:"startedAt"`
 	CompletedAt string    `json:"completedAt"`
+	BatchErrors
 }
 
 type MeetingBatchReadOptions struct {
@@ -119,9 +166,38 @@ type MeetingBatchUpdateOptions struct {
 
 type MeetingBatchUpdateProperties struct {
 	Id         string                          `json:"id"`
+	IdProperty string                          `json:"idProperty,omitempty"`
 	Properties MeetingCreateOrUpdateProperties `json:"properties"`
 }
 
+// MeetingBatchUpsertOptions creates or updates the meetings whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type MeetingBatchUpsertOptions struct {
+	IdProperty string                         `json:"-"`
+	Inputs     []MeetingBatchUpdateProperties `json:"inputs"`
+}
+
+func (o MeetingBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options MeetingBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *MeetingBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type MeetingBatchUpsertOutput struct {
+	Status      string                `json:"status"`
+	Results     []MeetingUpsertResult `json:"results"`
+	RequestedAt string                `json:"requestedAt"`
+	StartedAt   string                `json:"startedAt"`
+	CompletedAt string                `json:"completedAt"`
+	BatchErrors
+}
+
+// MeetingUpsertResult is an upserted meeting. New is true when it was created and false when it was updated.
+type MeetingUpsertResult struct {
+	Meeting
+	New bool `json:"new"`
+}
+
 type MeetingSearchOptions struct {
 	SearchOptions
 }
@@ -260,59 +336,39 @@ func (z *meetings) BatchArchive(ctx context.Context, meetingIds []string) error
 		options.Inputs = append(options.Inputs, BatchInput{Id: meetingId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *meetings) BatchCreate(ctx context.Context, options *MeetingBatchCreateOptions) (*MeetingBatchOutput, error) {
 	u := "/crm/v3/objects/meetings/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	meetings := &MeetingBatchOutput{}
 
-	err = z.client.do(req, meetings)
-	if err != nil {
-		return nil, err
-	}
-	return meetings, nil
+	err := z.client.doBatch(ctx, u, options, meetings)
+	return batchResult(meetings, err)
 }
 
 func (z *meetings) BatchRead(ctx context.Context, options *MeetingBatchReadOptions) (*MeetingBatchOutput, error) {
 	u := "/crm/v3/objects/meetings/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	meetings := &MeetingBatchOutput{}
 
-	err = z.client.do(req, meetings)
-	if err != nil {
-		return nil, err
-	}
-	return meetings, nil
+	err := z.client.doBatch(ctx, u, options, meetings)
+	return batchResult(meetings, err)
 }
 
 func (z *meetings) BatchUpdate(ctx context.Context, options *MeetingBatchUpdateOptions) (*MeetingBatchOutput, error) {
 	u := "/crm/v3/objects/meetings/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	meetings := &MeetingBatchOutput{}
 
-	err = z.client.do(req, meetings)
-	if err != nil {
-		return nil, err
-	}
-	return meetings, nil
+	err := z.client.doBatch(ctx, u, options, meetings)
+	return batchResult(meetings, err)
+}
+
+func (z *meetings) BatchUpsert(ctx context.Context, options *MeetingBatchUpsertOptions) (*MeetingBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/meetings/batch/upsert"
+	meetings := &MeetingBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, meetings)
+	return batchResult(meetings, err)
 }
 
 func (z *meetings) Search(ctx context.Context, options *MeetingSearchOptions) (*MeetingSearchResults, error) {
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Notes interface {
 	BatchCreate(ctx context.Context, options *NoteBatchCreateOptions) (*NoteBatchOutput, error)
 	BatchRead(ctx context.Context, options *NoteBatchReadOptions) (*NoteBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *NoteBatchUpdateOptions) (*NoteBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *NoteBatchUpsertOptions) (*NoteBatchUpsertOutput, error)
 	Search(ctx context.Context, options *NoteSearchOptions) (*NoteSearchResults, error)
 	Merge(ctx context.Context, options *NoteMergeOptions) (*Note, error)
 }
@@ -50,11 +52,13 @@ type NoteList struct {
 }
 
 type Note struct {
-	Id         string         `json:"id"`
-	Properties NoteProperties `json:"properties"`
-	CreatedAt  string         `json:"createdAt"`
-	UpdatedAt  string         `json:"updatedAt"`
-	Archived   bool           `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            NoteProperties     `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type NoteProperties struct {
@@ -63,6 +67,29 @@ type NoteProperties struct {
 	HsNoteBody         string `json:"hs_note_body,omitempty"`
 	HsTimestamp        string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId     string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p NoteProperties) MarshalJSON() ([]byte, error) {
+	type properties NoteProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *NoteProperties) UnmarshalJSON(b []byte) error {
+	type properties NoteProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+}
+
+// CreateOrUpdateProperties returns the writable properties of p, including its custom properties in Raw,
+// so that a read note can be modified and passed to Update without losing them.
+func (p NoteProperties) CreateOrUpdateProperties() NoteCreateOrUpdateProperties {
+	return NoteCreateOrUpdateProperties{
+		HsNoteBody:     p.HsNoteBody,
+		HsTimestamp:    p.HsTimestamp,
+		HubSpotOwnerId: p.HubSpotOwnerId,
+		Raw:            writableRawProperties(p.Raw),
+	}
 }
 
 type NoteCreateOrUpdateOptions struct {
@@ -73,6 +100,18 @@ type NoteCreateOrUpdateProperties struct {
 	HsNoteBody     string `json:"hs_note_body,omitempty"`
 	HsTimestamp    string `json:"hs_timestamp,omitempty"`
 	HubSpotOwnerId string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p NoteCreateOrUpdateProperties) MarshalJSON() ([]byte, error) {
+	type properties NoteCreateOrUpdateProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *NoteCreateOrUpdateProperties) UnmarshalJSON(b []byte) error {
+	type properties NoteCreateOrUpdateProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type NoteReadQuery struct {
@@ -89,6 +128,7 @@ type NoteBatchOutput struct {
 	RequestedAt string `json:"requestedAt"`
 	StartedAt   string `json:"startedAt"`
 	CompletedAt string `json:"completedAt"`
+	BatchErrors
 }
 
 type NoteBatchReadOptions struct {
@@ -105,9 +145,38 @@ type NoteBatchUpdateOptions struct {
 
 type NoteBatchUpdateProperties struct {
 	Id         string                       `json:"id"`
+	IdProperty string                       `json:"idProperty,omitempty"`
 	Properties NoteCreateOrUpdateProperties `json:"properties"`
 }
 
+// NoteBatchUpsertOptions creates or updates the notes whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type NoteBatchUpsertOptions struct {
+	IdProperty string                      `json:"-"`
+	Inputs     []NoteBatchUpdateProperties `json:"inputs"`
+}
+
+func (o NoteBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options NoteBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *NoteBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type NoteBatchUpsertOutput struct {
+	Status      string             `json:"status"`
+	Results     []NoteUpsertResult `json:"results"`
+	RequestedAt string             `json:"requestedAt"`
+	StartedAt   string             `json:"startedAt"`
+	CompletedAt string             `json:"completedAt"`
+	BatchErrors
+}
+
+// NoteUpsertResult is an upserted note. New is true when it was created and false when it was updated.
+type NoteUpsertResult struct {
+	Note
+	New bool `json:"new"`
+}
+
 type NoteSearchOptions struct {
 	SearchOptions
 }
@@ -246,59 +315,39 @@ func (z *notes) BatchArchive(ctx context.Context, noteIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: noteId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *notes) BatchCreate(ctx context.Context, options *NoteBatchCreateOptions) (*NoteBatchOutput, error) {
 	u := "/crm/v3/objects/notes/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	notes := &NoteBatchOutput{}
 
-	err = z.client.do(req, notes)
-	if err != nil {
-		return nil, err
-	}
-	return notes, nil
+	err := z.client.doBatch(ctx, u, options, notes)
+	return batchResult(notes, err)
 }
 
 func (z *notes) BatchRead(ctx context.Context, options *NoteBatchReadOptions) (*NoteBatchOutput, error) {
 	u := "/crm/v3/objects/notes/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	notes := &NoteBatchOutput{}
 
-	err = z.client.do(req, notes)
-	if err != nil {
-		return nil, err
-	}
-	return notes, nil
+	err := z.client.doBatch(ctx, u, options, notes)
+	return batchResult(notes, err)
 }
 
 func (z *notes) BatchUpdate(ctx context.Context, options *NoteBatchUpdateOptions) (*NoteBatchOutput, error) {
 	u := "/crm/v3/objects/notes/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	notes := &NoteBatchOutput{}
 
-	err = z.client.do(req, notes)
-	if err != nil {
-		return nil, err
-	}
-	return notes, nil
+	err := z.client.doBatch(ctx, u, options, notes)
+	return batchResult(notes, err)
+}
+
+func (z *notes) BatchUpsert(ctx context.Context, options *NoteBatchUpsertOptions) (*NoteBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/notes/batch/upsert"
+	notes := &NoteBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, notes)
+	return batchResult(notes, err)
 }
 
 func (z *notes) Search(ctx context.Context, options *NoteSearchOptions) (*NoteSearchResults, error) {
@@ -0,0 +1,224 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultAuthorizeAddress = "https://app.hubspot.com/oauth/authorize"
+
+	// tokenExpiryDelta is how long before expiry an access token is refreshed
+	tokenExpiryDelta = time.Minute
+)
+
+// OAuthConfig describes a HubSpot public app using the OAuth 2.0 authorization code flow.
+type OAuthConfig struct {
+	ClientId     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+	// BaseURL defaults to DefaultAddress.
+	BaseURL string
+	// HTTPClient defaults to an http.Client with a 30 second timeout.
+	HTTPClient *http.Client
+	// OnTokenRefresh is called with every newly issued token so it can be persisted.
+	OnTokenRefresh func(OAuthToken)
+}
+
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresIn    int64     `json:"expires_in"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// OAuthRefreshError is returned when an access token could not be obtained from /oauth/v1/token.
+// The underlying error is usually an *ErrorResponse.
+type OAuthRefreshError struct {
+	GrantType string
+	Err       error
+}
+
+func (e *OAuthRefreshError) Error() string {
+	return fmt.Sprintf("hubspot: oauth %s grant failed: %v", e.GrantType, e.Err)
+}
+
+func (e *OAuthRefreshError) Unwrap() error {
+	return e.Err
+}
+
+// OAuthTokenSource is a TokenSource which refreshes its access token before it expires.
+// It is safe for concurrent use; concurrent callers share a single refresh.
+type OAuthTokenSource struct {
+	config     *OAuthConfig
+	mu         sync.Mutex
+	token      OAuthToken
+	refreshing *tokenRefresh
+}
+
+// tokenRefresh is a refresh in flight, done is closed once token and err are set.
+type tokenRefresh struct {
+	done  chan struct{}
+	token OAuthToken
+	err   error
+}
+
+// AuthCodeURL returns the URL to send the user to in order to install the app.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("client_id", c.ClientId)
+	v.Set("redirect_uri", c.RedirectURI)
+	v.Set("scope", strings.Join(c.Scopes, " "))
+	if state != "" {
+		v.Set("state", state)
+	}
+	return DefaultAuthorizeAddress + "?" + v.Encode()
+}
+
+// Exchange trades an authorization code for a token and returns a TokenSource holding it.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*OAuthTokenSource, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("redirect_uri", c.RedirectURI)
+	v.Set("code", code)
+
+	token, err := c.requestToken(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	c.notifyRefresh(*token)
+	return c.TokenSource(*token), nil
+}
+
+// TokenSource returns a TokenSource for a previously obtained token. A token without an
+// access token or expiry is refreshed on first use.
+func (c *OAuthConfig) TokenSource(token OAuthToken) *OAuthTokenSource {
+	return &OAuthTokenSource{config: c, token: token}
+}
+
+// Token returns the current access token, refreshing it when it is about to expire. Callers arriving
+// during a refresh wait for its result, or until their ctx is done. OnTokenRefresh is called once the
+// new token is stored, without holding any lock, so it may call CurrentToken.
+func (s *OAuthTokenSource) Token(ctx context.Context) (string, error) {
+	for {
+		s.mu.Lock()
+		if s.token.AccessToken != "" && time.Until(s.token.Expiry) > tokenExpiryDelta {
+			token := s.token.AccessToken
+			s.mu.Unlock()
+			return token, nil
+		}
+		if refresh := s.refreshing; refresh != nil {
+			s.mu.Unlock()
+			select {
+			case <-refresh.done:
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			// A failed refresh, e.g. one whose caller gave up, is retried with our own ctx
+			if refresh.err == nil {
+				return refresh.token.AccessToken, nil
+			}
+			continue
+		}
+		refresh := &tokenRefresh{done: make(chan struct{})}
+		s.refreshing = refresh
+		refreshToken := s.token.RefreshToken
+		s.mu.Unlock()
+
+		return s.refresh(ctx, refresh, refreshToken)
+	}
+}
+
+func (s *OAuthTokenSource) refresh(ctx context.Context, refresh *tokenRefresh, refreshToken string) (string, error) {
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+
+	token, err := s.config.requestToken(ctx, v)
+	if err == nil {
+		// HubSpot may leave out the refresh token when it is unchanged, keep the one we have
+		if token.RefreshToken == "" {
+			token.RefreshToken = refreshToken
+		}
+		refresh.token = *token
+	}
+	refresh.err = err
+
+	s.mu.Lock()
+	if err == nil {
+		s.token = refresh.token
+	}
+	s.refreshing = nil
+	s.mu.Unlock()
+	close(refresh.done)
+
+	if err != nil {
+		return "", err
+	}
+	s.config.notifyRefresh(refresh.token)
+	return refresh.token.AccessToken, nil
+}
+
+// CurrentToken returns the token currently held, e.g. to persist it between runs.
+func (s *OAuthTokenSource) CurrentToken() OAuthToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+func (c *OAuthConfig) requestToken(ctx context.Context, v url.Values) (*OAuthToken, error) {
+	grantType := v.Get("grant_type")
+	v.Set("client_id", c.ClientId)
+	v.Set("client_secret", c.ClientSecret)
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultAddress
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(baseURL, "/")+"/oauth/v1/token", strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, &OAuthRefreshError{GrantType: grantType, Err: err}
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &OAuthRefreshError{GrantType: grantType, Err: err}
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, &OAuthRefreshError{GrantType: grantType, Err: err}
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, &OAuthRefreshError{GrantType: grantType, Err: newErrorResponse(res, resBody)}
+	}
+
+	token := &OAuthToken{}
+	if err = json.Unmarshal(resBody, token); err != nil {
+		return nil, &OAuthRefreshError{GrantType: grantType, Err: err}
+	}
+	token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return token, nil
+}
+
+func (c *OAuthConfig) notifyRefresh(token OAuthToken) {
+	if c.OnTokenRefresh != nil {
+		c.OnTokenRefresh(token)
+	}
+}
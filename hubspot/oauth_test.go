@@ -0,0 +1,151 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOAuthTokenSourceKeepsRefreshToken(t *testing.T) {
+	var refreshTokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+		// expires_in 0 forces a refresh on every call, no refresh_token is returned
+		fmt.Fprintf(w, `{"access_token":"access-%d","expires_in":0}`, len(refreshTokens))
+	}))
+	defer srv.Close()
+
+	var persisted OAuthToken
+	config := &OAuthConfig{BaseURL: srv.URL, OnTokenRefresh: func(token OAuthToken) { persisted = token }}
+	source := config.TokenSource(OAuthToken{RefreshToken: "refresh"})
+
+	for i := 1; i <= 2; i++ {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != fmt.Sprintf("access-%d", i) {
+			t.Fatalf("token = %q", token)
+		}
+	}
+	if refreshTokens[0] != "refresh" || refreshTokens[1] != "refresh" {
+		t.Fatalf("refresh tokens sent = %q", refreshTokens)
+	}
+	if source.CurrentToken().RefreshToken != "refresh" || persisted.RefreshToken != "refresh" {
+		t.Fatalf("refresh token dropped: current %+v, persisted %+v", source.CurrentToken(), persisted)
+	}
+}
+
+func TestOAuthTokenSourceReplacesRotatedRefreshToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"access","refresh_token":"rotated","expires_in":1800}`))
+	}))
+	defer srv.Close()
+
+	source := (&OAuthConfig{BaseURL: srv.URL}).TokenSource(OAuthToken{RefreshToken: "refresh"})
+	if _, err := source.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := source.CurrentToken().RefreshToken; got != "rotated" {
+		t.Fatalf("refresh token = %q, want rotated", got)
+	}
+}
+
+func TestOAuthTokenSourceSharesConcurrentRefresh(t *testing.T) {
+	var refreshes int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&refreshes, 1)
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":1800}`))
+	}))
+	defer srv.Close()
+
+	source := (&OAuthConfig{BaseURL: srv.URL}).TokenSource(OAuthToken{RefreshToken: "refresh"})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := source.Token(context.Background())
+			if err == nil && token != "access" {
+				err = fmt.Errorf("token = %q", token)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if refreshes != 1 {
+		t.Fatalf("%d refreshes, want 1", refreshes)
+	}
+}
+
+func TestOAuthTokenSourceCallbackReadsCurrentToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":1800}`))
+	}))
+	defer srv.Close()
+
+	var source *OAuthTokenSource
+	var persisted OAuthToken
+	config := &OAuthConfig{BaseURL: srv.URL, OnTokenRefresh: func(OAuthToken) { persisted = source.CurrentToken() }}
+	source = config.TokenSource(OAuthToken{RefreshToken: "refresh"})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := source.Token(context.Background())
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Token deadlocked on a callback calling CurrentToken")
+	}
+	if persisted.AccessToken != "access" {
+		t.Fatalf("callback read %+v, want the refreshed token", persisted)
+	}
+}
+
+func TestOAuthTokenSourceWaiterHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":1800}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	source := (&OAuthConfig{BaseURL: srv.URL}).TokenSource(OAuthToken{RefreshToken: "refresh"})
+	go source.Token(context.Background())
+	for {
+		source.mu.Lock()
+		started := source.refreshing != nil
+		source.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := source.Token(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want the waiter's deadline", err)
+	}
+}
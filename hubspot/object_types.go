@@ -0,0 +1,97 @@
+package hubspot
+
+// ObjectType identifies a CRM object type, either by name such as "contacts" or by objectTypeId such as "0-1".
+// Custom object types are identified by their fully qualified name or their objectTypeId, e.g. "2-1234567".
+type ObjectType string
+
+const (
+	ObjectTypeContacts            ObjectType = "contacts"
+	ObjectTypeCompanies           ObjectType = "companies"
+	ObjectTypeDeals               ObjectType = "deals"
+	ObjectTypeTickets             ObjectType = "tickets"
+	ObjectTypeProducts            ObjectType = "products"
+	ObjectTypeLineItems           ObjectType = "line_items"
+	ObjectTypeQuotes              ObjectType = "quotes"
+	ObjectTypeCommunications      ObjectType = "communications"
+	ObjectTypeFeedbackSubmissions ObjectType = "feedback_submissions"
+	ObjectTypeTasks               ObjectType = "tasks"
+	ObjectTypeNotes               ObjectType = "notes"
+	ObjectTypeMeetings            ObjectType = "meetings"
+	ObjectTypeCalls               ObjectType = "calls"
+	ObjectTypeEmails              ObjectType = "emails"
+	ObjectTypeInvoices            ObjectType = "invoices"
+	ObjectTypePostalMail          ObjectType = "postal_mail"
+	ObjectTypeLeads               ObjectType = "leads"
+
+	ObjectTypeIdContacts            ObjectType = "0-1"
+	ObjectTypeIdCompanies           ObjectType = "0-2"
+	ObjectTypeIdDeals               ObjectType = "0-3"
+	ObjectTypeIdTickets             ObjectType = "0-5"
+	ObjectTypeIdProducts            ObjectType = "0-7"
+	ObjectTypeIdLineItems           ObjectType = "0-8"
+	ObjectTypeIdQuotes              ObjectType = "0-14"
+	ObjectTypeIdCommunications      ObjectType = "0-18"
+	ObjectTypeIdFeedbackSubmissions ObjectType = "0-19"
+	ObjectTypeIdTasks               ObjectType = "0-27"
+	ObjectTypeIdNotes               ObjectType = "0-46"
+	ObjectTypeIdMeetings            ObjectType = "0-47"
+	ObjectTypeIdCalls               ObjectType = "0-48"
+	ObjectTypeIdEmails              ObjectType = "0-49"
+	ObjectTypeIdInvoices            ObjectType = "0-53"
+	ObjectTypeIdPostalMail          ObjectType = "0-116"
+	ObjectTypeIdLeads               ObjectType = "0-136"
+)
+
+var objectTypeIds = map[ObjectType]ObjectType{
+	ObjectTypeContacts:            ObjectTypeIdContacts,
+	ObjectTypeCompanies:           ObjectTypeIdCompanies,
+	ObjectTypeDeals:               ObjectTypeIdDeals,
+	ObjectTypeTickets:             ObjectTypeIdTickets,
+	ObjectTypeProducts:            ObjectTypeIdProducts,
+	ObjectTypeLineItems:           ObjectTypeIdLineItems,
+	ObjectTypeQuotes:              ObjectTypeIdQuotes,
+	ObjectTypeCommunications:      ObjectTypeIdCommunications,
+	ObjectTypeFeedbackSubmissions: ObjectTypeIdFeedbackSubmissions,
+	ObjectTypeTasks:               ObjectTypeIdTasks,
+	ObjectTypeNotes:               ObjectTypeIdNotes,
+	ObjectTypeMeetings:            ObjectTypeIdMeetings,
+	ObjectTypeCalls:               ObjectTypeIdCalls,
+	ObjectTypeEmails:              ObjectTypeIdEmails,
+	ObjectTypeInvoices:            ObjectTypeIdInvoices,
+	ObjectTypePostalMail:          ObjectTypeIdPostalMail,
+	ObjectTypeLeads:               ObjectTypeIdLeads,
+}
+
+var objectTypeNames = func() map[ObjectType]ObjectType {
+	names := make(map[ObjectType]ObjectType, len(objectTypeIds))
+	for name, id := range objectTypeIds {
+		names[id] = name
+	}
+	return names
+}()
+
+func (t ObjectType) String() string {
+	return string(t)
+}
+
+// Id returns the objectTypeId of a standard object type. Any other object type is returned unchanged.
+func (t ObjectType) Id() ObjectType {
+	if id, ok := objectTypeIds[t]; ok {
+		return id
+	}
+	return t
+}
+
+// Name returns the name of a standard object type. Any other object type is returned unchanged.
+func (t ObjectType) Name() ObjectType {
+	if name, ok := objectTypeNames[t]; ok {
+		return name
+	}
+	return t
+}
+
+// IsStandard reports whether t is one of the object types defined by HubSpot.
+func (t ObjectType) IsStandard() bool {
+	_, ok := objectTypeIds[t.Name()]
+	return ok
+}
@@ -0,0 +1,357 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Objects works with any CRM object type, including custom objects, addressed by name (e.g. "contacts",
+// "p_subscriptions") or objectTypeId (e.g. "0-1", "2-1234567"). Properties are exposed as RawProperties;
+// use NewObjectClient to work with a property struct instead.
+type Objects interface {
+	List(ctx context.Context, objectType ObjectType, query *ObjectListQuery) (*ObjectList, error)
+	Create(ctx context.Context, objectType ObjectType, options *ObjectCreateOrUpdateOptions) (*Object, error)
+	Read(ctx context.Context, objectType ObjectType, objectId string, query *ObjectReadQuery) (*Object, error)
+	Update(ctx context.Context, objectType ObjectType, objectId string, options *ObjectCreateOrUpdateOptions) (*Object, error)
+	Archive(ctx context.Context, objectType ObjectType, objectId string) error
+	BatchArchive(ctx context.Context, objectType ObjectType, objectIds []string) error
+	BatchCreate(ctx context.Context, objectType ObjectType, options *ObjectBatchCreateOptions) (*ObjectBatchOutput, error)
+	BatchRead(ctx context.Context, objectType ObjectType, options *ObjectBatchReadOptions) (*ObjectBatchOutput, error)
+	BatchUpdate(ctx context.Context, objectType ObjectType, options *ObjectBatchUpdateOptions) (*ObjectBatchOutput, error)
+	BatchUpsert(ctx context.Context, objectType ObjectType, options *ObjectBatchUpsertOptions) (*ObjectBatchUpsertOutput, error)
+	Search(ctx context.Context, objectType ObjectType, options *ObjectSearchOptions) (*ObjectSearchResults, error)
+	Merge(ctx context.Context, objectType ObjectType, options *ObjectMergeOptions) (*Object, error)
+}
+
+type objects struct {
+	client *Client
+}
+
+type ObjectListQuery struct {
+	ListQuery
+}
+
+type ObjectReadQuery struct {
+	ReadQuery
+}
+
+type ObjectBatchReadOptions struct {
+	BatchReadOptions
+}
+
+type ObjectSearchOptions struct {
+	SearchOptions
+}
+
+type ObjectMergeOptions struct {
+	MergeOptions
+}
+
+// GenericObject is a CRM object whose properties are decoded into P.
+type GenericObject[P any] struct {
+	Id                    string             `json:"id"`
+	Properties            P                  `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
+}
+
+type GenericObjectList[P any] struct {
+	Results []GenericObject[P] `json:"results"`
+	Pagination
+}
+
+type GenericObjectCreateOrUpdateOptions[P any] struct {
+	Properties   P             `json:"properties"`
+	Associations []Association `json:"associations,omitempty"`
+}
+
+type GenericObjectBatchCreateOptions[P any] struct {
+	Inputs []GenericObjectCreateOrUpdateOptions[P] `json:"inputs"`
+}
+
+type GenericObjectBatchUpdateOptions[P any] struct {
+	Inputs []GenericObjectBatchUpdateProperties[P] `json:"inputs"`
+}
+
+type GenericObjectBatchUpdateProperties[P any] struct {
+	Id         string `json:"id"`
+	IdProperty string `json:"idProperty,omitempty"`
+	Properties P      `json:"properties"`
+}
+
+// GenericObjectBatchUpsertOptions creates or updates the objects whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type GenericObjectBatchUpsertOptions[P any] struct {
+	IdProperty string                                  `json:"-"`
+	Inputs     []GenericObjectBatchUpdateProperties[P] `json:"inputs"`
+}
+
+func (o GenericObjectBatchUpsertOptions[P]) MarshalJSON() ([]byte, error) {
+	type options GenericObjectBatchUpsertOptions[P]
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *GenericObjectBatchUpdateProperties[P]) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type GenericObjectBatchUpsertOutput[P any] struct {
+	Status      string                         `json:"status"`
+	Results     []GenericObjectUpsertResult[P] `json:"results"`
+	RequestedAt string                         `json:"requestedAt"`
+	StartedAt   string                         `json:"startedAt"`
+	CompletedAt string                         `json:"completedAt"`
+	BatchErrors
+}
+
+// GenericObjectUpsertResult is an upserted object. New is true when it was created and false when it was updated.
+type GenericObjectUpsertResult[P any] struct {
+	GenericObject[P]
+	New bool `json:"new"`
+}
+
+type GenericObjectBatchOutput[P any] struct {
+	Status      string             `json:"status"`
+	Results     []GenericObject[P] `json:"results"`
+	RequestedAt string             `json:"requestedAt"`
+	StartedAt   string             `json:"startedAt"`
+	CompletedAt string             `json:"completedAt"`
+	BatchErrors
+}
+
+type GenericObjectSearchResults[P any] struct {
+	Total   int64              `json:"total"`
+	Results []GenericObject[P] `json:"results"`
+	Pagination
+}
+
+type (
+	Object                      = GenericObject[RawProperties]
+	ObjectList                  = GenericObjectList[RawProperties]
+	ObjectCreateOrUpdateOptions = GenericObjectCreateOrUpdateOptions[RawProperties]
+	ObjectBatchCreateOptions    = GenericObjectBatchCreateOptions[RawProperties]
+	ObjectBatchUpdateOptions    = GenericObjectBatchUpdateOptions[RawProperties]
+	ObjectBatchUpdateProperties = GenericObjectBatchUpdateProperties[RawProperties]
+	ObjectBatchOutput           = GenericObjectBatchOutput[RawProperties]
+	ObjectBatchUpsertOptions    = GenericObjectBatchUpsertOptions[RawProperties]
+	ObjectBatchUpsertOutput     = GenericObjectBatchUpsertOutput[RawProperties]
+	ObjectUpsertResult          = GenericObjectUpsertResult[RawProperties]
+	ObjectSearchResults         = GenericObjectSearchResults[RawProperties]
+)
+
+// ObjectClient is bound to a single object type and decodes properties into P, which may be a
+// caller supplied property struct or RawProperties.
+type ObjectClient[P any] struct {
+	client     *Client
+	objectType ObjectType
+}
+
+// NewObjectClient creates an ObjectClient for objectType, e.g.
+//
+//	subscriptions := hubspot.NewObjectClient[SubscriptionProperties](client, "2-1234567")
+func NewObjectClient[P any](client *Client, objectType ObjectType) *ObjectClient[P] {
+	return &ObjectClient[P]{client: client, objectType: objectType}
+}
+
+func (z *ObjectClient[P]) List(ctx context.Context, query *ObjectListQuery) (*GenericObjectList[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s", z.objectType)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ol := &GenericObjectList[P]{}
+
+	err = z.client.do(req, ol)
+	if err != nil {
+		return nil, err
+	}
+	return ol, nil
+}
+
+func (z *ObjectClient[P]) Create(ctx context.Context, options *GenericObjectCreateOrUpdateOptions[P]) (*GenericObject[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s", z.objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &GenericObject[P]{}
+
+	err = z.client.do(req, object)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func (z *ObjectClient[P]) Read(ctx context.Context, objectId string, query *ObjectReadQuery) (*GenericObject[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/%s", z.objectType, objectId)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &GenericObject[P]{}
+
+	err = z.client.do(req, object)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func (z *ObjectClient[P]) Update(ctx context.Context, objectId string, options *GenericObjectCreateOrUpdateOptions[P]) (*GenericObject[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/%s", z.objectType, objectId)
+	req, err := z.client.newHttpRequest(ctx, "PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &GenericObject[P]{}
+
+	err = z.client.do(req, object)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func (z *ObjectClient[P]) Archive(ctx context.Context, objectId string) error {
+	u := fmt.Sprintf("/crm/v3/objects/%s/%s", z.objectType, objectId)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
+
+func (z *ObjectClient[P]) BatchArchive(ctx context.Context, objectIds []string) error {
+	u := fmt.Sprintf("/crm/v3/objects/%s/batch/archive", z.objectType)
+
+	options := BatchInputOptions{}
+	options.Inputs = make([]BatchInput, 0)
+
+	for _, objectId := range objectIds {
+		options.Inputs = append(options.Inputs, BatchInput{Id: objectId})
+	}
+
+	return z.client.doBatch(ctx, u, options, nil)
+}
+
+func (z *ObjectClient[P]) BatchCreate(ctx context.Context, options *GenericObjectBatchCreateOptions[P]) (*GenericObjectBatchOutput[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/batch/create", z.objectType)
+	objects := &GenericObjectBatchOutput[P]{}
+
+	err := z.client.doBatch(ctx, u, options, objects)
+	return batchResult(objects, err)
+}
+
+func (z *ObjectClient[P]) BatchRead(ctx context.Context, options *ObjectBatchReadOptions) (*GenericObjectBatchOutput[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/batch/read", z.objectType)
+	objects := &GenericObjectBatchOutput[P]{}
+
+	err := z.client.doBatch(ctx, u, options, objects)
+	return batchResult(objects, err)
+}
+
+func (z *ObjectClient[P]) BatchUpdate(ctx context.Context, options *GenericObjectBatchUpdateOptions[P]) (*GenericObjectBatchOutput[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/batch/update", z.objectType)
+	objects := &GenericObjectBatchOutput[P]{}
+
+	err := z.client.doBatch(ctx, u, options, objects)
+	return batchResult(objects, err)
+}
+
+func (z *ObjectClient[P]) BatchUpsert(ctx context.Context, options *GenericObjectBatchUpsertOptions[P]) (*GenericObjectBatchUpsertOutput[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/batch/upsert", z.objectType)
+	objects := &GenericObjectBatchUpsertOutput[P]{}
+
+	err := z.client.doBatch(ctx, u, options, objects)
+	return batchResult(objects, err)
+}
+
+func (z *ObjectClient[P]) Search(ctx context.Context, options *ObjectSearchOptions) (*GenericObjectSearchResults[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/search", z.objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := &GenericObjectSearchResults[P]{}
+
+	err = z.client.do(req, objects)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (z *ObjectClient[P]) Merge(ctx context.Context, options *ObjectMergeOptions) (*GenericObject[P], error) {
+	u := fmt.Sprintf("/crm/v3/objects/%s/merge", z.objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &GenericObject[P]{}
+
+	err = z.client.do(req, object)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func (z *objects) of(objectType ObjectType) *ObjectClient[RawProperties] {
+	return NewObjectClient[RawProperties](z.client, objectType)
+}
+
+func (z *objects) List(ctx context.Context, objectType ObjectType, query *ObjectListQuery) (*ObjectList, error) {
+	return z.of(objectType).List(ctx, query)
+}
+
+func (z *objects) Create(ctx context.Context, objectType ObjectType, options *ObjectCreateOrUpdateOptions) (*Object, error) {
+	return z.of(objectType).Create(ctx, options)
+}
+
+func (z *objects) Read(ctx context.Context, objectType ObjectType, objectId string, query *ObjectReadQuery) (*Object, error) {
+	return z.of(objectType).Read(ctx, objectId, query)
+}
+
+func (z *objects) Update(ctx context.Context, objectType ObjectType, objectId string, options *ObjectCreateOrUpdateOptions) (*Object, error) {
+	return z.of(objectType).Update(ctx, objectId, options)
+}
+
+func (z *objects) Archive(ctx context.Context, objectType ObjectType, objectId string) error {
+	return z.of(objectType).Archive(ctx, objectId)
+}
+
+func (z *objects) BatchArchive(ctx context.Context, objectType ObjectType, objectIds []string) error {
+	return z.of(objectType).BatchArchive(ctx, objectIds)
+}
+
+func (z *objects) BatchCreate(ctx context.Context, objectType ObjectType, options *ObjectBatchCreateOptions) (*ObjectBatchOutput, error) {
+	return z.of(objectType).BatchCreate(ctx, options)
+}
+
+func (z *objects) BatchRead(ctx context.Context, objectType ObjectType, options *ObjectBatchReadOptions) (*ObjectBatchOutput, error) {
+	return z.of(objectType).BatchRead(ctx, options)
+}
+
+func (z *objects) BatchUpdate(ctx context.Context, objectType ObjectType, options *ObjectBatchUpdateOptions) (*ObjectBatchOutput, error) {
+	return z.of(objectType).BatchUpdate(ctx, options)
+}
+
+func (z *objects) BatchUpsert(ctx context.Context, objectType ObjectType, options *ObjectBatchUpsertOptions) (*ObjectBatchUpsertOutput, error) {
+	return z.of(objectType).BatchUpsert(ctx, options)
+}
+
+func (z *objects) Search(ctx context.Context, objectType ObjectType, options *ObjectSearchOptions) (*ObjectSearchResults, error) {
+	return z.of(objectType).Search(ctx, options)
+}
+
+func (z *objects) Merge(ctx context.Context, objectType ObjectType, options *ObjectMergeOptions) (*Object, error) {
+	return z.of(objectType).Merge(ctx, options)
+}
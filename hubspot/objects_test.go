@@ -0,0 +1,137 @@
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+type subscriptionProperties struct {
+	Name  string `json:"name,omitempty"`
+	Seats Number `json:"seats,omitempty"`
+}
+
+const subscriptionJSON = `{
+	"id": "42",
+	"properties": {"name": "Pro", "seats": "12", "hs_object_id": "42"},
+	"createdAt": "2024-01-01T00:00:00Z",
+	"updatedAt": "2024-01-02T00:00:00Z",
+	"archived": false
+}`
+
+func TestObjectClientRead(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/objects/2-1234567/42": {Body: subscriptionJSON},
+	})
+	subscriptions := NewObjectClient[subscriptionProperties](client, "2-1234567")
+
+	object, err := subscriptions.Read(context.Background(), "42", &ObjectReadQuery{ReadQuery{Properties: []string{"name", "seats"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.last(t).Query.Get("properties"); got != "name,seats" {
+		t.Errorf("properties query = %q", got)
+	}
+	if object.Id != "42" || object.Properties.Name != "Pro" || object.Properties.Seats.Int64() != 12 || object.UpdatedAt != "2024-01-02T00:00:00Z" {
+		t.Errorf("object = %+v", object)
+	}
+}
+
+func TestObjectClientCreate(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v3/objects/p_subscriptions": {Status: http.StatusCreated, Body: subscriptionJSON},
+	})
+	subscriptions := NewObjectClient[subscriptionProperties](client, "p_subscriptions")
+
+	object, err := subscriptions.Create(context.Background(), &GenericObjectCreateOrUpdateOptions[subscriptionProperties]{
+		Properties: subscriptionProperties{Name: "Pro", Seats: NewInt(12)},
+		Associations: []Association{{
+			To:    AssociationTo{Id: "7"},
+			Types: []AssociationCreateOptions{{Category: UserDefined, TypeId: 3}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{
+		"properties": {"name": "Pro", "seats": "12"},
+		"associations": [{"to": {"id": "7"}, "types": [{"associationCategory": "USER_DEFINED", "associationTypeId": 3}]}]
+	}`)
+	if object.Id != "42" {
+		t.Errorf("object = %+v", object)
+	}
+}
+
+func TestObjectClientUpdate(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"PATCH /crm/v3/objects/2-1234567/42": {Body: subscriptionJSON},
+	})
+	subscriptions := NewObjectClient[subscriptionProperties](client, "2-1234567")
+
+	_, err := subscriptions.Update(context.Background(), "42", &GenericObjectCreateOrUpdateOptions[subscriptionProperties]{
+		Properties: subscriptionProperties{Name: "Pro", Seats: NewInt(12)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"properties": {"name": "Pro", "seats": "12"}}`)
+}
+
+func TestObjectClientSearch(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"POST /crm/v3/objects/2-1234567/search": {Body: `{"total": 1, "results": [` + subscriptionJSON + `], "paging": {"next": {"after": "1"}}}`},
+	})
+	subscriptions := NewObjectClient[subscriptionProperties](client, "2-1234567")
+
+	options := &ObjectSearchOptions{}
+	if err := Where("name").Eq("Pro").Apply(&options.SearchOptions); err != nil {
+		t.Fatal(err)
+	}
+	options.Limit = 10
+	results, err := subscriptions.Search(context.Background(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"filterGroups": [{"filters": [{"propertyName": "name", "operator": "EQ", "value": "Pro"}]}], "limit": 10}`)
+	if results.Total != 1 || len(results.Results) != 1 || results.Results[0].Properties.Seats.Int64() != 12 || results.NextAfter() != "1" {
+		t.Errorf("results = %+v", results)
+	}
+}
+
+func TestObjectsUsesRawProperties(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/objects/2-1234567/42":    {Body: subscriptionJSON},
+		"PATCH /crm/v3/objects/2-1234567/42":  {Body: subscriptionJSON},
+		"DELETE /crm/v3/objects/2-1234567/42": {Status: http.StatusNoContent},
+	})
+
+	object, err := client.Objects.Read(context.Background(), "2-1234567", "42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if object.Properties.Get("seats") != "12" || object.Properties.Get("hs_object_id") != "42" {
+		t.Errorf("properties = %v", object.Properties)
+	}
+
+	_, err = client.Objects.Update(context.Background(), "2-1234567", "42", &ObjectCreateOrUpdateOptions{Properties: RawProperties{"seats": "13"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSON(t, s.last(t).Body, `{"properties": {"seats": "13"}}`)
+
+	if err := client.Objects.Archive(context.Background(), "2-1234567", "42"); err != nil {
+		t.Fatal(err)
+	}
+	if r := s.last(t); r.Method != "DELETE" || r.Path != "/crm/v3/objects/2-1234567/42" {
+		t.Errorf("archive sent %s %s", r.Method, r.Path)
+	}
+}
+
+func TestObjectClientNotFound(t *testing.T) {
+	client, _ := newRouteClient(t, nil)
+
+	object, err := NewObjectClient[subscriptionProperties](client, "2-1234567").Read(context.Background(), "404", nil)
+	if object != nil || !IsNotFound(err) {
+		t.Fatalf("object %+v, err %v", object, err)
+	}
+}
@@ -0,0 +1,163 @@
+package hubspot
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	baseURL          string
+	httpClient       *http.Client
+	transport        http.RoundTripper
+	timeout          time.Duration
+	userAgentSuffix  string
+	headers          http.Header
+	logger           *slog.Logger
+	retry            *RetryPolicy
+	retrySet         bool
+	limiter          *RateLimiter
+	limiterSet       bool
+	searchLimiter    *RateLimiter
+	searchSet        bool
+	batchConcurrency int
+	developerAPIKey  string
+}
+
+// WithBaseURL points the Client at a different API host, e.g. a recording proxy or an httptest.Server.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the http.Client used to perform requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the http.RoundTripper of the Client's http.Client.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout sets the timeout of the Client's http.Client. The default is 30 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithUserAgent appends suffix to the User-Agent sent with every request.
+func WithUserAgent(suffix string) Option {
+	return func(o *clientOptions) {
+		o.userAgentSuffix = suffix
+	}
+}
+
+// WithHeader adds a header which is sent with every request.
+func WithHeader(key string, value string) Option {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithLogger sets the logger used to report retried requests.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = logger
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. Passing nil disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retry = policy
+		o.retrySet = true
+	}
+}
+
+// WithRateLimiter replaces the default limiter used for all calls except search. Passing nil disables it.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.limiter = limiter
+		o.limiterSet = true
+	}
+}
+
+// WithSearchRateLimiter replaces the default limiter used for the /search endpoints. Passing nil disables it.
+func WithSearchRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.searchLimiter = limiter
+		o.searchSet = true
+	}
+}
+
+// WithBatchConcurrency sets the number of requests of a batch call split into chunks of MaxBatchInputs
+// which run at the same time. The default is DefaultBatchConcurrency.
+func WithBatchConcurrency(n int) Option {
+	return func(o *clientOptions) {
+		o.batchConcurrency = n
+	}
+}
+
+// WithDeveloperAPIKey sets the developer API key used to call the developer APIs of an app, e.g. Webhooks.
+func WithDeveloperAPIKey(key string) Option {
+	return func(o *clientOptions) {
+		o.developerAPIKey = key
+	}
+}
+
+func (o *clientOptions) apply(client *Client) {
+	if o.baseURL != "" {
+		client.baseURL = o.baseURL
+	}
+	if o.httpClient != nil {
+		client.http = o.httpClient
+	}
+	if o.transport != nil || o.timeout > 0 {
+		// Copy so a caller supplied http.Client is never mutated
+		httpClient := *client.http
+		if o.transport != nil {
+			httpClient.Transport = o.transport
+		}
+		if o.timeout > 0 {
+			httpClient.Timeout = o.timeout
+		}
+		client.http = &httpClient
+	}
+	if o.userAgentSuffix != "" {
+		client.userAgent = client.userAgent + " " + o.userAgentSuffix
+	}
+	for k, v := range o.headers {
+		client.headers[k] = append(client.headers[k], v...)
+	}
+	if o.logger != nil {
+		client.logger = o.logger
+	}
+	if o.retrySet {
+		client.retry = o.retry
+	}
+	if o.limiterSet {
+		client.limiter = o.limiter
+	}
+	if o.searchSet {
+		client.searchLimiter = o.searchLimiter
+	}
+	if o.batchConcurrency > 0 {
+		client.batchConcurrency = o.batchConcurrency
+	}
+	if o.developerAPIKey != "" {
+		client.developerAPIKey = o.developerAPIKey
+	}
+}
@@ -16,7 +16,7 @@ type owners struct {
 
 type OwnerListQuery struct {
 	Email    string `url:"email,omitempty"`
-	After    string `ur:"after,omitempty"`
+	After    string `url:"after,omitempty"`
 	Limit    string `url:"limit,omitempty"`
 	Archived bool   `url:"archived,omitempty"`
 }
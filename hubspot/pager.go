@@ -0,0 +1,161 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// ErrNilPage is returned by a Pager created with PagerOf when a fetch returns neither a response nor an error.
+var ErrNilPage = errors.New("hubspot: page request returned no response")
+
+// Paged is implemented by every response embedding Pagination.
+type Paged interface {
+	NextAfter() string
+}
+
+// NextAfter returns the cursor of the next page, or an empty string on the last page.
+func (p Pagination) NextAfter() string {
+	return p.Paging.Next.After
+}
+
+// PageFunc fetches the page starting at the after cursor and returns its items together with the cursor of the next page.
+type PageFunc[T any] func(ctx context.Context, after string) ([]T, string, error)
+
+// Pager walks a paginated endpoint one page at a time:
+//
+//	pager := hubspot.PagerOf(func(ctx context.Context, after string) (*hubspot.ContactList, error) {
+//		query.After = after
+//		return client.Contacts.List(ctx, query)
+//	}, func(l *hubspot.ContactList) []hubspot.Contact { return l.Contacts })
+//	for pager.Next(ctx) {
+//		for _, contact := range pager.Page() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch   PageFunc[T]
+	current string
+	after   string
+	page    []T
+	pos     int
+	err     error
+	started bool
+	done    bool
+}
+
+// NewPager creates a Pager starting at the first page.
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// PagerOf creates a Pager over a service call returning a Paged response such as *ContactList,
+// *DealSearchResults or *AssociationList. items extracts the results from each response.
+func PagerOf[R Paged, T any](fetch func(ctx context.Context, after string) (R, error), items func(R) []T) *Pager[T] {
+	return NewPager(func(ctx context.Context, after string) ([]T, string, error) {
+		res, err := fetch(ctx, after)
+		if err != nil {
+			return nil, "", err
+		}
+		if v := reflect.ValueOf(res); !v.IsValid() || v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil, "", ErrNilPage
+		}
+		return items(res), res.NextAfter(), nil
+	})
+}
+
+// StartAfter resumes paging from a cursor returned by a previous After call.
+func (p *Pager[T]) StartAfter(after string) *Pager[T] {
+	p.after = after
+	return p
+}
+
+// Next fetches the next page and reports whether one was fetched. It returns false once the
+// last page has been consumed, ctx is done or a request failed; Err tells the two apart.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if p.started && p.after == "" {
+		p.done = true
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	page, after, err := p.fetch(ctx, p.after)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.started = true
+	p.current = p.after
+	p.page = page
+	p.pos = len(page)
+	p.after = after
+	return true
+}
+
+// Page returns the items of the page fetched by the last call to Next.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// After returns the cursor to resume paging from later with StartAfter. It is the cursor of the page following
+// the current one, unless ForEach or CollectN stopped before the end of the current page: HubSpot cursors
+// point at pages, so After then returns the cursor of the current page and resuming repeats the items of
+// that page which were already read rather than skipping the unread ones.
+func (p *Pager[T]) After() string {
+	if p.pos < len(p.page) {
+		return p.current
+	}
+	return p.after
+}
+
+// Err returns the error which stopped the Pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// ForEach calls fn for every remaining item until fn returns false or the last page has been read.
+// When fn stops early, the next ForEach or CollectN call continues with the item following the last one
+// passed to fn.
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(T) bool) error {
+	for {
+		for p.pos < len(p.page) {
+			item := p.page[p.pos]
+			p.pos++
+			if !fn(item) {
+				return nil
+			}
+		}
+		if !p.Next(ctx) {
+			return p.err
+		}
+		p.pos = 0
+	}
+}
+
+// CollectAll reads every remaining page and returns all items.
+func (p *Pager[T]) CollectAll(ctx context.Context) ([]T, error) {
+	return p.CollectN(ctx, -1)
+}
+
+// CollectN reads pages until n items have been gathered or the last page has been read.
+// A negative n collects everything.
+func (p *Pager[T]) CollectN(ctx context.Context, n int) ([]T, error) {
+	all := make([]T, 0)
+	if n == 0 {
+		return all, nil
+	}
+	err := p.ForEach(ctx, func(item T) bool {
+		all = append(all, item)
+		return n < 0 || len(all) < n
+	})
+	return all, err
+}
@@ -0,0 +1,157 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// pages serves the items 0 to n-1 in pages of size, with the index of the first item of a page as its cursor.
+func pages(n int, size int, calls *[]string) PageFunc[int] {
+	return func(ctx context.Context, after string) ([]int, string, error) {
+		*calls = append(*calls, after)
+		start, _ := strconv.Atoi(after)
+		var page []int
+		for i := start; i < min(start+size, n); i++ {
+			page = append(page, i)
+		}
+		next := ""
+		if start+size < n {
+			next = strconv.Itoa(start + size)
+		}
+		return page, next, nil
+	}
+}
+
+func TestPagerMultiplePages(t *testing.T) {
+	var calls []string
+	p := NewPager(pages(7, 3, &calls))
+
+	var sizes []int
+	for p.Next(context.Background()) {
+		sizes = append(sizes, len(p.Page()))
+	}
+	if p.Err() != nil {
+		t.Fatal(p.Err())
+	}
+	if want := []int{3, 3, 1}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("page sizes = %v, want %v", sizes, want)
+	}
+	if want := []string{"", "3", "6"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("cursors = %q, want %q", calls, want)
+	}
+	if p.Next(context.Background()) {
+		t.Errorf("Next returned true after the last page")
+	}
+}
+
+func TestPagerCollectNContinuesWithinPage(t *testing.T) {
+	var calls []string
+	p := NewPager(pages(7, 3, &calls))
+
+	first, err := p.CollectN(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rest, err := p.CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, []int{0, 1}) || !reflect.DeepEqual(rest, []int{2, 3, 4, 5, 6}) {
+		t.Errorf("got %v then %v, want every item once", first, rest)
+	}
+	if len(calls) != 3 {
+		t.Errorf("fetched %d pages, want 3", len(calls))
+	}
+}
+
+func TestPagerAfterEarlyStop(t *testing.T) {
+	var calls []string
+	p := NewPager(pages(7, 3, &calls))
+
+	// Stopping in the middle of the second page resumes from that page, repeating item 3 rather than losing 5
+	seen, err := p.CollectN(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.After() != "3" {
+		t.Fatalf("After = %q, want the cursor of the partly read page", p.After())
+	}
+	resumed, err := NewPager(pages(7, 3, &calls)).StartAfter(p.After()).CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(seen, []int{0, 1, 2, 3, 4}) || !reflect.DeepEqual(resumed, []int{3, 4, 5, 6}) {
+		t.Errorf("got %v then %v", seen, resumed)
+	}
+
+	// Stopping at the end of a page resumes from the next one
+	p = NewPager(pages(7, 3, &calls))
+	if _, err := p.CollectN(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+	if p.After() != "3" {
+		t.Errorf("After = %q, want the cursor of the next page", p.After())
+	}
+}
+
+func TestPagerStartAfter(t *testing.T) {
+	var calls []string
+	items, err := NewPager(pages(7, 3, &calls)).StartAfter("6").CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(items, []int{6}) || !reflect.DeepEqual(calls, []string{"6"}) {
+		t.Errorf("items %v, cursors %q", items, calls)
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	failure := errors.New("failure")
+	calls := 0
+	p := NewPager(func(ctx context.Context, after string) ([]int, string, error) {
+		calls++
+		if after == "" {
+			return []int{1, 2}, "next", nil
+		}
+		return nil, "", failure
+	})
+
+	items, err := p.CollectAll(context.Background())
+	if !errors.Is(err, failure) {
+		t.Fatalf("err = %v, want the fetch error", err)
+	}
+	if !reflect.DeepEqual(items, []int{1, 2}) {
+		t.Errorf("items = %v, want the items read before the failure", items)
+	}
+	if p.Next(context.Background()) || calls != 2 {
+		t.Errorf("Pager kept fetching after an error")
+	}
+	if p.After() != "next" {
+		t.Errorf("After = %q, want the cursor of the failed page", p.After())
+	}
+}
+
+func TestPagerCanceledContext(t *testing.T) {
+	var calls []string
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewPager(pages(7, 3, &calls)).ForEach(ctx, func(int) bool { return true }); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("fetched %d pages with a canceled context", len(calls))
+	}
+}
+
+func TestPagerOfNilResponse(t *testing.T) {
+	p := PagerOf(func(ctx context.Context, after string) (*ListMembershipList, error) {
+		return nil, nil
+	}, func(l *ListMembershipList) []ListMembership { return l.Results })
+
+	if _, err := p.CollectAll(context.Background()); !errors.Is(err, ErrNilPage) {
+		t.Fatalf("err = %v, want ErrNilPage", err)
+	}
+}
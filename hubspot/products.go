@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Products interface {
 	BatchCreate(ctx context.Context, options *ProductBatchCreateOptions) (*ProductBatchOutput, error)
 	BatchRead(ctx context.Context, options *ProductBatchReadOptions) (*ProductBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *ProductBatchUpdateOptions) (*ProductBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *ProductBatchUpsertOptions) (*ProductBatchUpsertOutput, error)
 	Search(ctx context.Context, options *ProductSearchOptions) (*ProductSearchResults, error)
 	Merge(ctx context.Context, options *ProductMergeOptions) (*Product, error)
 }
@@ -50,11 +52,13 @@ type ProductList struct {
 }
 
 type Product struct {
-	Id         string            `json:"id"`
-	Properties ProductProperties `json:"properties"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  string            `json:"updatedAt"`
-	Archived   bool              `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            ProductProperties  `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type ProductCreateOrUpdateOptions struct {
@@ -71,6 +75,7 @@ type ProductBatchOutput struct {
 	RequestedAt string    `json:"requestedAt"`
 	StartedAt   string    `json:"startedAt"`
 	CompletedAt string    `json:"completedAt"`
+	BatchErrors
 }
 
 type ProductBatchReadOptions struct {
@@ -87,9 +92,38 @@ type ProductBatchUpdateOptions struct {
 
 type ProductBatchUpdateProperties struct {
 	Id         string            `json:"id"`
+	IdProperty string            `json:"idProperty,omitempty"`
 	Properties ProductProperties `json:"properties"`
 }
 
+// ProductBatchUpsertOptions creates or updates the products whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type ProductBatchUpsertOptions struct {
+	IdProperty string                         `json:"-"`
+	Inputs     []ProductBatchUpdateProperties `json:"inputs"`
+}
+
+func (o ProductBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options ProductBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *ProductBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type ProductBatchUpsertOutput struct {
+	Status      string                `json:"status"`
+	Results     []ProductUpsertResult `json:"results"`
+	RequestedAt string                `json:"requestedAt"`
+	StartedAt   string                `json:"startedAt"`
+	CompletedAt string                `json:"completedAt"`
+	BatchErrors
+}
+
+// ProductUpsertResult is an upserted product. New is true when it was created and false when it was updated.
+type ProductUpsertResult struct {
+	Product
+	New bool `json:"new"`
+}
+
 type ProductSearchOptions struct {
 	SearchOptions
 }
@@ -228,59 +262,39 @@ func (z *products) BatchArchive(ctx context.Context, productIds []string) error
 		options.Inputs = append(options.Inputs, BatchInput{Id: productId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *products) BatchCreate(ctx context.Context, options *ProductBatchCreateOptions) (*ProductBatchOutput, error) {
 	u := "/crm/v3/objects/products/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	products := &ProductBatchOutput{}
 
-	err = z.client.do(req, products)
-	if err != nil {
-		return nil, err
-	}
-	return products, nil
+	err := z.client.doBatch(ctx, u, options, products)
+	return batchResult(products, err)
 }
 
 func (z *products) BatchRead(ctx context.Context, options *ProductBatchReadOptions) (*ProductBatchOutput, error) {
 	u := "/crm/v3/objects/products/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	products := &ProductBatchOutput{}
 
-	err = z.client.do(req, products)
-	if err != nil {
-		return nil, err
-	}
-	return products, nil
+	err := z.client.doBatch(ctx, u, options, products)
+	return batchResult(products, err)
 }
 
 func (z *products) BatchUpdate(ctx context.Context, options *ProductBatchUpdateOptions) (*ProductBatchOutput, error) {
 	u := "/crm/v3/objects/products/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	products := &ProductBatchOutput{}
 
-	err = z.client.do(req, products)
-	if err != nil {
-		return nil, err
-	}
-	return products, nil
+	err := z.client.doBatch(ctx, u, options, products)
+	return batchResult(products, err)
+}
+
+func (z *products) BatchUpsert(ctx context.Context, options *ProductBatchUpsertOptions) (*ProductBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/products/batch/upsert"
+	products := &ProductBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, products)
+	return batchResult(products, err)
 }
 
 func (z *products) Search(ctx context.Context, options *ProductSearchOptions) (*ProductSearchResults, error) {
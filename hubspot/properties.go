@@ -0,0 +1,365 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+)
+
+type Properties interface {
+	List(ctx context.Context, objectType ObjectType, query *PropertyListQuery) (*PropertyList, error)
+	Create(ctx context.Context, objectType ObjectType, options *PropertyCreateOptions) (*Property, error)
+	Read(ctx context.Context, objectType ObjectType, propertyName string, query *PropertyReadQuery) (*Property, error)
+	Update(ctx context.Context, objectType ObjectType, propertyName string, options *PropertyUpdateOptions) (*Property, error)
+	Archive(ctx context.Context, objectType ObjectType, propertyName string) error
+	BatchArchive(ctx context.Context, objectType ObjectType, propertyNames []string) error
+	BatchCreate(ctx context.Context, objectType ObjectType, options *PropertyBatchCreateOptions) (*PropertyBatchOutput, error)
+	BatchRead(ctx context.Context, objectType ObjectType, options *PropertyBatchReadOptions) (*PropertyBatchOutput, error)
+	ListGroups(ctx context.Context, objectType ObjectType) (*PropertyGroupList, error)
+	CreateGroup(ctx context.Context, objectType ObjectType, options *PropertyGroupCreateOptions) (*PropertyGroup, error)
+	ReadGroup(ctx context.Context, objectType ObjectType, groupName string) (*PropertyGroup, error)
+	UpdateGroup(ctx context.Context, objectType ObjectType, groupName string, options *PropertyGroupUpdateOptions) (*PropertyGroup, error)
+	ArchiveGroup(ctx context.Context, objectType ObjectType, groupName string) error
+}
+
+type properties struct {
+	client *Client
+}
+
+type PropertyType string
+
+const (
+	PropertyTypeString      PropertyType = "string"
+	PropertyTypeNumber      PropertyType = "number"
+	PropertyTypeDate        PropertyType = "date"
+	PropertyTypeDateTime    PropertyType = "datetime"
+	PropertyTypeEnumeration PropertyType = "enumeration"
+	PropertyTypeBool        PropertyType = "bool"
+	PropertyTypePhoneNumber PropertyType = "phone_number"
+)
+
+type PropertyFieldType string
+
+const (
+	FieldTypeText                PropertyFieldType = "text"
+	FieldTypeTextarea            PropertyFieldType = "textarea"
+	FieldTypeNumber              PropertyFieldType = "number"
+	FieldTypeDate                PropertyFieldType = "date"
+	FieldTypeFile                PropertyFieldType = "file"
+	FieldTypeSelect              PropertyFieldType = "select"
+	FieldTypeRadio               PropertyFieldType = "radio"
+	FieldTypeCheckbox            PropertyFieldType = "checkbox"
+	FieldTypeBooleanCheckbox     PropertyFieldType = "booleancheckbox"
+	FieldTypeCalculationEquation PropertyFieldType = "calculation_equation"
+	FieldTypeHtml                PropertyFieldType = "html"
+	FieldTypePhoneNumber         PropertyFieldType = "phonenumber"
+)
+
+// Property is the definition of a CRM object property.
+type Property struct {
+	Name                 string                       `json:"name"`
+	Label                string                       `json:"label"`
+	Type                 PropertyType                 `json:"type"`
+	FieldType            PropertyFieldType            `json:"fieldType"`
+	Description          string                       `json:"description"`
+	GroupName            string                       `json:"groupName"`
+	Options              []PropertyOption             `json:"options"`
+	DisplayOrder         int64                        `json:"displayOrder"`
+	Calculated           bool                         `json:"calculated"`
+	CalculationFormula   string                       `json:"calculationFormula,omitempty"`
+	ExternalOptions      bool                         `json:"externalOptions"`
+	HasUniqueValue       bool                         `json:"hasUniqueValue"`
+	Hidden               bool                         `json:"hidden"`
+	HubspotDefined       bool                         `json:"hubspotDefined,omitempty"`
+	ShowCurrencySymbol   bool                         `json:"showCurrencySymbol,omitempty"`
+	FormField            bool                         `json:"formField"`
+	ReferencedObjectType string                       `json:"referencedObjectType,omitempty"`
+	DataSensitivity      string                       `json:"dataSensitivity,omitempty"`
+	ModificationMetadata PropertyModificationMetadata `json:"modificationMetadata"`
+	CreatedUserId        string                       `json:"createdUserId,omitempty"`
+	UpdatedUserId        string                       `json:"updatedUserId,omitempty"`
+	CreatedAt            string                       `json:"createdAt,omitempty"`
+	UpdatedAt            string                       `json:"updatedAt,omitempty"`
+	ArchivedAt           string                       `json:"archivedAt,omitempty"`
+	Archived             bool                         `json:"archived"`
+}
+
+type PropertyOption struct {
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+	Description  string `json:"description,omitempty"`
+	DisplayOrder int64  `json:"displayOrder,omitempty"`
+	Hidden       bool   `json:"hidden"`
+}
+
+type PropertyModificationMetadata struct {
+	Archivable         bool `json:"archivable"`
+	ReadOnlyDefinition bool `json:"readOnlyDefinition"`
+	ReadOnlyOptions    bool `json:"readOnlyOptions,omitempty"`
+	ReadOnlyValue      bool `json:"readOnlyValue"`
+}
+
+type PropertyCreateOptions struct {
+	Name                 string            `json:"name"`
+	Label                string            `json:"label"`
+	Type                 PropertyType      `json:"type"`
+	FieldType            PropertyFieldType `json:"fieldType"`
+	GroupName            string            `json:"groupName,omitempty"`
+	Description          string            `json:"description,omitempty"`
+	Options              []PropertyOption  `json:"options,omitempty"`
+	DisplayOrder         int64             `json:"displayOrder,omitempty"`
+	CalculationFormula   string            `json:"calculationFormula,omitempty"`
+	ExternalOptions      bool              `json:"externalOptions,omitempty"`
+	HasUniqueValue       bool              `json:"hasUniqueValue,omitempty"`
+	Hidden               bool              `json:"hidden,omitempty"`
+	FormField            bool              `json:"formField,omitempty"`
+	ReferencedObjectType string            `json:"referencedObjectType,omitempty"`
+}
+
+type PropertyListQuery struct {
+	Archived   bool     `url:"archived,omitempty"`
+	Properties []string `url:"properties,omitempty"`
+}
+
+type PropertyList struct {
+	Results []Property `json:"results"`
+}
+
+type PropertyReadQuery struct {
+	Archived bool `url:"archived,omitempty"`
+}
+
+type PropertyUpdateOptions struct {
+	Label              string            `json:"label,omitempty"`
+	Type               PropertyType      `json:"type,omitempty"`
+	FieldType          PropertyFieldType `json:"fieldType,omitempty"`
+	GroupName          string            `json:"groupName,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	Options            []PropertyOption  `json:"options,omitempty"`
+	DisplayOrder       int64             `json:"displayOrder,omitempty"`
+	CalculationFormula string            `json:"calculationFormula,omitempty"`
+	Hidden             *bool             `json:"hidden,omitempty"`
+	FormField          *bool             `json:"formField,omitempty"`
+}
+
+type PropertyBatchCreateOptions struct {
+	Inputs []PropertyCreateOptions `json:"inputs"`
+}
+
+type PropertyBatchReadOptions struct {
+	Archived bool                `json:"archived"`
+	Inputs   []PropertyNameInput `json:"inputs"`
+}
+
+type PropertyNameInput struct {
+	Name string `json:"name"`
+}
+
+type PropertyBatchInputOptions struct {
+	Inputs []PropertyNameInput `json:"inputs"`
+}
+
+type PropertyBatchOutput struct {
+	Status      string     `json:"status"`
+	Results     []Property `json:"results"`
+	RequestedAt string     `json:"requestedAt"`
+	StartedAt   string     `json:"startedAt"`
+	CompletedAt string     `json:"completedAt"`
+	BatchErrors
+}
+
+type PropertyGroup struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int64  `json:"displayOrder"`
+	Archived     bool   `json:"archived"`
+}
+
+type PropertyGroupList struct {
+	Results []PropertyGroup `json:"results"`
+}
+
+type PropertyGroupCreateOptions struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int64  `json:"displayOrder,omitempty"`
+}
+
+type PropertyGroupUpdateOptions struct {
+	Label        string `json:"label,omitempty"`
+	DisplayOrder int64  `json:"displayOrder,omitempty"`
+}
+
+func (z *properties) List(ctx context.Context, objectType ObjectType, query *PropertyListQuery) (*PropertyList, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s", objectType)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &PropertyList{}
+
+	err = z.client.do(req, pl)
+	if err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+func (z *properties) Create(ctx context.Context, objectType ObjectType, options *PropertyCreateOptions) (*Property, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s", objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	property := &Property{}
+
+	err = z.client.do(req, property)
+	if err != nil {
+		return nil, err
+	}
+	return property, nil
+}
+
+func (z *properties) Read(ctx context.Context, objectType ObjectType, propertyName string, query *PropertyReadQuery) (*Property, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/%s", objectType, propertyName)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	property := &Property{}
+
+	err = z.client.do(req, property)
+	if err != nil {
+		return nil, err
+	}
+	return property, nil
+}
+
+func (z *properties) Update(ctx context.Context, objectType ObjectType, propertyName string, options *PropertyUpdateOptions) (*Property, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/%s", objectType, propertyName)
+	req, err := z.client.newHttpRequest(ctx, "PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	property := &Property{}
+
+	err = z.client.do(req, property)
+	if err != nil {
+		return nil, err
+	}
+	return property, nil
+}
+
+func (z *properties) Archive(ctx context.Context, objectType ObjectType, propertyName string) error {
+	u := fmt.Sprintf("/crm/v3/properties/%s/%s", objectType, propertyName)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
+
+func (z *properties) BatchArchive(ctx context.Context, objectType ObjectType, propertyNames []string) error {
+	u := fmt.Sprintf("/crm/v3/properties/%s/batch/archive", objectType)
+
+	options := PropertyBatchInputOptions{}
+	options.Inputs = make([]PropertyNameInput, 0)
+
+	for _, propertyName := range propertyNames {
+		options.Inputs = append(options.Inputs, PropertyNameInput{Name: propertyName})
+	}
+
+	return z.client.doBatch(ctx, u, options, nil)
+}
+
+func (z *properties) BatchCreate(ctx context.Context, objectType ObjectType, options *PropertyBatchCreateOptions) (*PropertyBatchOutput, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/batch/create", objectType)
+	props := &PropertyBatchOutput{}
+
+	err := z.client.doBatch(ctx, u, options, props)
+	return batchResult(props, err)
+}
+
+func (z *properties) BatchRead(ctx context.Context, objectType ObjectType, options *PropertyBatchReadOptions) (*PropertyBatchOutput, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/batch/read", objectType)
+	props := &PropertyBatchOutput{}
+
+	err := z.client.doBatch(ctx, u, options, props)
+	return batchResult(props, err)
+}
+
+func (z *properties) ListGroups(ctx context.Context, objectType ObjectType) (*PropertyGroupList, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/groups", objectType)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pgl := &PropertyGroupList{}
+
+	err = z.client.do(req, pgl)
+	if err != nil {
+		return nil, err
+	}
+	return pgl, nil
+}
+
+func (z *properties) CreateGroup(ctx context.Context, objectType ObjectType, options *PropertyGroupCreateOptions) (*PropertyGroup, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/groups", objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &PropertyGroup{}
+
+	err = z.client.do(req, group)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (z *properties) ReadGroup(ctx context.Context, objectType ObjectType, groupName string) (*PropertyGroup, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/groups/%s", objectType, groupName)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &PropertyGroup{}
+
+	err = z.client.do(req, group)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (z *properties) UpdateGroup(ctx context.Context, objectType ObjectType, groupName string, options *PropertyGroupUpdateOptions) (*PropertyGroup, error) {
+	u := fmt.Sprintf("/crm/v3/properties/%s/groups/%s", objectType, groupName)
+	req, err := z.client.newHttpRequest(ctx, "PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &PropertyGroup{}
+
+	err = z.client.do(req, group)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (z *properties) ArchiveGroup(ctx context.Context, objectType ObjectType, groupName string) error {
+	u := fmt.Sprintf("/crm/v3/properties/%s/groups/%s", objectType, groupName)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
@@ -0,0 +1,170 @@
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const propertyJSON = `{
+	"name": "plan",
+	"label": "Plan",
+	"type": "enumeration",
+	"fieldType": "select",
+	"groupName": "subscriptioninformation",
+	"options": [{"label": "Pro", "value": "pro", "displayOrder": 1, "hidden": false}],
+	"hubspotDefined": false,
+	"modificationMetadata": {"archivable": true, "readOnlyDefinition": false, "readOnlyValue": false},
+	"archived": false
+}`
+
+const propertyGroupJSON = `{"name": "subscriptioninformation", "label": "Subscription information", "displayOrder": 2, "archived": false}`
+
+func TestPropertiesRequests(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/properties/deals":                                   {Body: `{"results":[` + propertyJSON + `]}`},
+		"POST /crm/v3/properties/deals":                                  {Status: http.StatusCreated, Body: propertyJSON},
+		"GET /crm/v3/properties/deals/plan":                              {Body: propertyJSON},
+		"PATCH /crm/v3/properties/deals/plan":                            {Body: propertyJSON},
+		"DELETE /crm/v3/properties/deals/plan":                           {Status: http.StatusNoContent},
+		"POST /crm/v3/properties/deals/batch/archive":                    {Status: http.StatusNoContent},
+		"POST /crm/v3/properties/deals/batch/create":                     {Status: http.StatusCreated, Body: `{"status":"COMPLETE","results":[` + propertyJSON + `]}`},
+		"POST /crm/v3/properties/deals/batch/read":                       {Body: `{"status":"COMPLETE","results":[` + propertyJSON + `]}`},
+		"GET /crm/v3/properties/deals/groups":                            {Body: `{"results":[` + propertyGroupJSON + `]}`},
+		"POST /crm/v3/properties/deals/groups":                           {Status: http.StatusCreated, Body: propertyGroupJSON},
+		"GET /crm/v3/properties/deals/groups/subscriptioninformation":    {Body: propertyGroupJSON},
+		"PATCH /crm/v3/properties/deals/groups/subscriptioninformation":  {Body: propertyGroupJSON},
+		"DELETE /crm/v3/properties/deals/groups/subscriptioninformation": {Status: http.StatusNoContent},
+	})
+	ctx := context.Background()
+	hidden := false
+
+	tests := []struct {
+		name   string
+		call   func() error
+		method string
+		path   string
+		query  string
+		body   string
+	}{
+		{"list", func() error {
+			list, err := client.Properties.List(ctx, ObjectTypeDeals, &PropertyListQuery{Archived: true})
+			if err == nil && (len(list.Results) != 1 || list.Results[0].Name != "plan") {
+				t.Errorf("list = %+v", list)
+			}
+			return err
+		}, "GET", "/crm/v3/properties/deals", "archived=true", ""},
+		{"create", func() error {
+			_, err := client.Properties.Create(ctx, ObjectTypeDeals, &PropertyCreateOptions{
+				Name:      "plan",
+				Label:     "Plan",
+				Type:      PropertyTypeEnumeration,
+				FieldType: FieldTypeSelect,
+				GroupName: "subscriptioninformation",
+				Options:   []PropertyOption{{Label: "Pro", Value: "pro", DisplayOrder: 1}},
+			})
+			return err
+		}, "POST", "/crm/v3/properties/deals", "", `{
+			"name": "plan",
+			"label": "Plan",
+			"type": "enumeration",
+			"fieldType": "select",
+			"groupName": "subscriptioninformation",
+			"options": [{"label": "Pro", "value": "pro", "displayOrder": 1, "hidden": false}]
+		}`},
+		{"read", func() error {
+			_, err := client.Properties.Read(ctx, ObjectTypeDeals, "plan", &PropertyReadQuery{Archived: true})
+			return err
+		}, "GET", "/crm/v3/properties/deals/plan", "archived=true", ""},
+		{"update", func() error {
+			_, err := client.Properties.Update(ctx, ObjectTypeDeals, "plan", &PropertyUpdateOptions{Label: "Plan name", Hidden: &hidden})
+			return err
+		}, "PATCH", "/crm/v3/properties/deals/plan", "", `{"label": "Plan name", "hidden": false}`},
+		{"archive", func() error {
+			return client.Properties.Archive(ctx, ObjectTypeDeals, "plan")
+		}, "DELETE", "/crm/v3/properties/deals/plan", "", ""},
+		{"batch archive", func() error {
+			return client.Properties.BatchArchive(ctx, ObjectTypeDeals, []string{"plan", "seats"})
+		}, "POST", "/crm/v3/properties/deals/batch/archive", "", `{"inputs": [{"name": "plan"}, {"name": "seats"}]}`},
+		{"batch create", func() error {
+			out, err := client.Properties.BatchCreate(ctx, ObjectTypeDeals, &PropertyBatchCreateOptions{
+				Inputs: []PropertyCreateOptions{{Name: "plan", Label: "Plan", Type: PropertyTypeString, FieldType: FieldTypeText}},
+			})
+			if err == nil && (out.Status != "COMPLETE" || len(out.Results) != 1) {
+				t.Errorf("output = %+v", out)
+			}
+			return err
+		}, "POST", "/crm/v3/properties/deals/batch/create", "", `{"inputs": [{"name": "plan", "label": "Plan", "type": "string", "fieldType": "text"}]}`},
+		{"batch read", func() error {
+			_, err := client.Properties.BatchRead(ctx, ObjectTypeDeals, &PropertyBatchReadOptions{Archived: true, Inputs: []PropertyNameInput{{Name: "plan"}}})
+			return err
+		}, "POST", "/crm/v3/properties/deals/batch/read", "", `{"archived": true, "inputs": [{"name": "plan"}]}`},
+		{"list groups", func() error {
+			list, err := client.Properties.ListGroups(ctx, ObjectTypeDeals)
+			if err == nil && (len(list.Results) != 1 || list.Results[0].DisplayOrder != 2) {
+				t.Errorf("groups = %+v", list)
+			}
+			return err
+		}, "GET", "/crm/v3/properties/deals/groups", "", ""},
+		{"create group", func() error {
+			_, err := client.Properties.CreateGroup(ctx, ObjectTypeDeals, &PropertyGroupCreateOptions{Name: "subscriptioninformation", Label: "Subscription information"})
+			return err
+		}, "POST", "/crm/v3/properties/deals/groups", "", `{"name": "subscriptioninformation", "label": "Subscription information"}`},
+		{"read group", func() error {
+			_, err := client.Properties.ReadGroup(ctx, ObjectTypeDeals, "subscriptioninformation")
+			return err
+		}, "GET", "/crm/v3/properties/deals/groups/subscriptioninformation", "", ""},
+		{"update group", func() error {
+			_, err := client.Properties.UpdateGroup(ctx, ObjectTypeDeals, "subscriptioninformation", &PropertyGroupUpdateOptions{DisplayOrder: 3})
+			return err
+		}, "PATCH", "/crm/v3/properties/deals/groups/subscriptioninformation", "", `{"displayOrder": 3}`},
+		{"archive group", func() error {
+			return client.Properties.ArchiveGroup(ctx, ObjectTypeDeals, "subscriptioninformation")
+		}, "DELETE", "/crm/v3/properties/deals/groups/subscriptioninformation", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			r := s.last(t)
+			if r.Method != tt.method || r.Path != tt.path || r.Query.Encode() != tt.query {
+				t.Errorf("sent %s %s?%s, want %s %s?%s", r.Method, r.Path, r.Query.Encode(), tt.method, tt.path, tt.query)
+			}
+			if tt.body != "" {
+				assertJSON(t, r.Body, tt.body)
+			} else if len(r.Body) != 0 {
+				t.Errorf("unexpected body %s", r.Body)
+			}
+		})
+	}
+}
+
+func TestPropertyDecoding(t *testing.T) {
+	client, _ := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/properties/deals/plan": {Body: propertyJSON},
+	})
+
+	property, err := client.Properties.Read(context.Background(), ObjectTypeDeals, "plan", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if property.Type != PropertyTypeEnumeration || property.FieldType != FieldTypeSelect || property.GroupName != "subscriptioninformation" {
+		t.Errorf("property = %+v", property)
+	}
+	if len(property.Options) != 1 || property.Options[0].Value != "pro" || property.Options[0].DisplayOrder != 1 {
+		t.Errorf("options = %+v", property.Options)
+	}
+	if !property.ModificationMetadata.Archivable {
+		t.Errorf("modification metadata = %+v", property.ModificationMetadata)
+	}
+}
+
+func TestPropertiesNotFound(t *testing.T) {
+	client, _ := newRouteClient(t, nil)
+
+	_, err := client.Properties.Read(context.Background(), ObjectTypeDeals, "missing", nil)
+	if !IsNotFound(err) {
+		t.Fatalf("err = %v, want not found", err)
+	}
+}
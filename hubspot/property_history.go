@@ -0,0 +1,50 @@
+package hubspot
+
+import (
+	"time"
+)
+
+// PropertyHistory holds the changes of every property requested with PropertiesWithHistory, keyed by property name.
+type PropertyHistory map[string][]PropertyChange
+
+// PropertyChange is a single value a property has held.
+type PropertyChange struct {
+	Value           string   `json:"value"`
+	Timestamp       DateTime `json:"timestamp"`
+	SourceType      string   `json:"sourceType,omitempty"`
+	SourceId        string   `json:"sourceId,omitempty"`
+	SourceLabel     string   `json:"sourceLabel,omitempty"`
+	UpdatedByUserId int64    `json:"updatedByUserId,omitempty"`
+}
+
+// LastChange returns the most recent change of the named property, e.g. to find who last changed it.
+// The history need not be sorted; of changes sharing a timestamp the one listed first wins, as
+// HubSpot lists history newest first.
+func (h PropertyHistory) LastChange(property string) (PropertyChange, bool) {
+	var last PropertyChange
+	found := false
+	for _, c := range h[property] {
+		if !found || c.Timestamp.After(last.Timestamp.Time) {
+			last = c
+			found = true
+		}
+	}
+	return last, found
+}
+
+// ValueAt returns the value the named property held at t, and false when it had no value yet or
+// its history was not requested. A change made exactly at t counts, and ties are broken as in LastChange.
+func (h PropertyHistory) ValueAt(property string, t time.Time) (string, bool) {
+	var at PropertyChange
+	found := false
+	for _, c := range h[property] {
+		if c.Timestamp.After(t) {
+			continue
+		}
+		if !found || c.Timestamp.After(at.Timestamp.Time) {
+			at = c
+			found = true
+		}
+	}
+	return at.Value, found
+}
@@ -0,0 +1,54 @@
+package hubspot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPropertyHistory(t *testing.T) {
+	at := func(day int) DateTime {
+		return DateTime{time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)}
+	}
+	history := PropertyHistory{
+		// Unsorted, with two changes on day 5 listed newest first.
+		"dealstage": {
+			{Value: "closedwon", Timestamp: at(5), SourceId: "second"},
+			{Value: "appointmentscheduled", Timestamp: at(1)},
+			{Value: "closedlost", Timestamp: at(5), SourceId: "first"},
+			{Value: "qualifiedtobuy", Timestamp: at(3)},
+		},
+		"amount": {},
+	}
+
+	tests := []struct {
+		name     string
+		property string
+		at       time.Time
+		value    string
+		found    bool
+	}{
+		{"before the first change", "dealstage", at(0).Time, "", false},
+		{"at the first change", "dealstage", at(1).Time, "appointmentscheduled", true},
+		{"between changes", "dealstage", at(4).Time, "qualifiedtobuy", true},
+		{"equal timestamps", "dealstage", at(5).Time, "closedwon", true},
+		{"after the last change", "dealstage", at(9).Time, "closedwon", true},
+		{"empty history", "amount", at(9).Time, "", false},
+		{"not requested", "closedate", at(9).Time, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found := history.ValueAt(tt.property, tt.at)
+			if value != tt.value || found != tt.found {
+				t.Errorf("ValueAt = %q, %v, want %q, %v", value, found, tt.value, tt.found)
+			}
+		})
+	}
+
+	last, found := history.LastChange("dealstage")
+	if !found || last.SourceId != "second" {
+		t.Errorf("LastChange = %+v, %v, want the change listed first on the latest day", last, found)
+	}
+	if _, found := history.LastChange("amount"); found {
+		t.Error("LastChange found a change in an empty history")
+	}
+}
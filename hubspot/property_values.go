@@ -0,0 +1,278 @@
+package hubspot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The typed property values below decode leniently from JSON strings and numbers, as HubSpot sends every
+// property value as a string. An unset value encodes as null, which the properties structs omit.
+
+const (
+	dateTimeFormat = "2006-01-02T15:04:05.000Z"
+	dateFormat     = "2006-01-02"
+)
+
+var jsonNull = []byte("null")
+
+// unquoteProperty returns the text of a JSON string or number, or "" for null.
+func unquoteProperty(b []byte) (string, error) {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, jsonNull) {
+		return "", nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		err := json.Unmarshal(b, &s)
+		return strings.TrimSpace(s), err
+	}
+	return string(b), nil
+}
+
+// parseEpochMillis parses a millisecond timestamp such as "1704153600000".
+func parseEpochMillis(s string) (time.Time, bool) {
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms).UTC(), true
+}
+
+// DateTime is a datetime property, sent by HubSpot as ISO 8601 or epoch milliseconds.
+type DateTime struct {
+	time.Time
+}
+
+func NewDateTime(t time.Time) DateTime {
+	return DateTime{Time: t}
+}
+
+// FilterValue returns the epoch milliseconds expected by search filters, or an empty string when d is zero.
+func (d DateTime) FilterValue() string {
+	if d.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(d.UnixMilli(), 10)
+}
+
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return jsonNull, nil
+	}
+	return json.Marshal(d.UTC().Format(dateTimeFormat))
+}
+
+func (d *DateTime) UnmarshalJSON(b []byte) error {
+	s, err := unquoteProperty(b)
+	if err != nil || s == "" {
+		d.Time = time.Time{}
+		return err
+	}
+	if t, ok := parseEpochMillis(s); ok {
+		d.Time = t
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, dateFormat} {
+		if t, err := time.Parse(layout, s); err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+	return fmt.Errorf("hubspot: invalid datetime property value %q", s)
+}
+
+// Date is a date property. HubSpot stores dates as midnight UTC.
+type Date struct {
+	time.Time
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// FilterValue returns the epoch milliseconds expected by search filters, or an empty string when d is zero.
+func (d Date) FilterValue() string {
+	if d.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(d.UnixMilli(), 10)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return jsonNull, nil
+	}
+	return json.Marshal(d.UTC().Format(dateFormat))
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	s, err := unquoteProperty(b)
+	if err != nil || s == "" {
+		d.Time = time.Time{}
+		return err
+	}
+	if t, ok := parseEpochMillis(s); ok {
+		d.Time = t
+		return nil
+	}
+	for _, layout := range []string{dateFormat, time.RFC3339Nano} {
+		if t, err := time.Parse(layout, s); err == nil {
+			d.Time = t.UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("hubspot: invalid date property value %q", s)
+}
+
+// Number is a number property. The decimal text is kept as sent so that amounts such as "12.50"
+// and large identifiers round-trip without loss.
+type Number struct {
+	text string
+}
+
+func NewNumber(f float64) Number {
+	return Number{text: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+func NewInt(i int64) Number {
+	return Number{text: strconv.FormatInt(i, 10)}
+}
+
+// ParseNumber parses the decimal text of a number property.
+func ParseNumber(s string) (Number, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Number{}, nil
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return Number{}, fmt.Errorf("hubspot: invalid number property value %q", s)
+	}
+	return Number{text: s}, nil
+}
+
+// IsSet reports whether the property has a value.
+func (n Number) IsSet() bool {
+	return n.text != ""
+}
+
+func (n Number) Float64() float64 {
+	f, _ := strconv.ParseFloat(n.text, 64)
+	return f
+}
+
+// Int64 returns the value truncated to an integer.
+func (n Number) Int64() int64 {
+	if i, err := strconv.ParseInt(n.text, 10, 64); err == nil {
+		return i
+	}
+	return int64(n.Float64())
+}
+
+func (n Number) String() string {
+	return n.text
+}
+
+func (n Number) MarshalJSON() ([]byte, error) {
+	if n.text == "" {
+		return jsonNull, nil
+	}
+	return json.Marshal(n.text)
+}
+
+func (n *Number) UnmarshalJSON(b []byte) error {
+	s, err := unquoteProperty(b)
+	if err != nil {
+		return err
+	}
+	*n, err = ParseNumber(s)
+	return err
+}
+
+// Bool is a bool property, sent by HubSpot as "true" or "false".
+type Bool struct {
+	value bool
+	set   bool
+}
+
+func NewBool(b bool) Bool {
+	return Bool{value: b, set: true}
+}
+
+// IsSet reports whether the property has a value.
+func (v Bool) IsSet() bool {
+	return v.set
+}
+
+// Value returns the value of the property, false when it is not set.
+func (v Bool) Value() bool {
+	return v.value
+}
+
+func (v Bool) MarshalJSON() ([]byte, error) {
+	if !v.set {
+		return jsonNull, nil
+	}
+	return json.Marshal(strconv.FormatBool(v.value))
+}
+
+func (v *Bool) UnmarshalJSON(b []byte) error {
+	s, err := unquoteProperty(b)
+	if err != nil || s == "" {
+		*v = Bool{}
+		return err
+	}
+	value, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("hubspot: invalid bool property value %q", s)
+	}
+	*v = NewBool(value)
+	return nil
+}
+
+// MultiSelect is a multiple checkbox enumeration property, sent by HubSpot as semicolon separated values.
+type MultiSelect []string
+
+// Contains reports whether value is selected.
+func (m MultiSelect) Contains(value string) bool {
+	return containsString(m, value)
+}
+
+func (m MultiSelect) String() string {
+	return strings.Join(m, ";")
+}
+
+func (m MultiSelect) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return jsonNull, nil
+	}
+	return json.Marshal(m.String())
+}
+
+func (m *MultiSelect) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var values []string
+		if err := json.Unmarshal(b, &values); err != nil {
+			return err
+		}
+		*m = values
+		return nil
+	}
+	s, err := unquoteProperty(b)
+	if err != nil || s == "" {
+		*m = nil
+		return err
+	}
+	values := make(MultiSelect, 0)
+	for _, v := range strings.Split(s, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	*m = values
+	return nil
+}
@@ -0,0 +1,161 @@
+package hubspot
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateTimeDecode(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC)
+	for _, in := range []string{`"2024-01-02T03:04:05.006Z"`, `"1704164645006"`, `1704164645006`} {
+		var d DateTime
+		if err := json.Unmarshal([]byte(in), &d); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !d.Equal(want) {
+			t.Fatalf("%s decoded to %s", in, d)
+		}
+	}
+	for _, in := range []string{`""`, `null`, `"  "`} {
+		d := NewDateTime(want)
+		if err := json.Unmarshal([]byte(in), &d); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !d.IsZero() {
+			t.Fatalf("%s decoded to %s", in, d)
+		}
+	}
+	var d DateTime
+	if err := json.Unmarshal([]byte(`"yesterday"`), &d); err == nil {
+		t.Fatal("expected an error for an invalid datetime")
+	}
+}
+
+func TestDateDecode(t *testing.T) {
+	want := NewDate(2024, time.March, 15)
+	for _, in := range []string{`"2024-03-15"`, `"1710460800000"`, `"2024-03-15T00:00:00Z"`} {
+		var d Date
+		if err := json.Unmarshal([]byte(in), &d); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !d.Equal(want.Time) {
+			t.Fatalf("%s decoded to %s", in, d)
+		}
+	}
+	var d Date
+	if err := json.Unmarshal([]byte(`""`), &d); err != nil || !d.IsZero() {
+		t.Fatalf("empty string decoded to %s, %v", d, err)
+	}
+}
+
+func TestDateTimeFilterValue(t *testing.T) {
+	if v := NewDateTime(time.UnixMilli(1704164645006)).FilterValue(); v != "1704164645006" {
+		t.Fatalf("FilterValue = %q", v)
+	}
+	if v := NewDate(2024, time.March, 15).FilterValue(); v != "1710460800000" {
+		t.Fatalf("FilterValue = %q", v)
+	}
+	if v := (DateTime{}).FilterValue(); v != "" {
+		t.Fatalf("zero DateTime FilterValue = %q", v)
+	}
+	if v := (Date{}).FilterValue(); v != "" {
+		t.Fatalf("zero Date FilterValue = %q", v)
+	}
+}
+
+func TestNumberDecode(t *testing.T) {
+	for in, want := range map[string]string{`"12.50"`: "12.50", `12.5`: "12.5", `"1234567890123456789"`: "1234567890123456789", `"-3"`: "-3"} {
+		var n Number
+		if err := json.Unmarshal([]byte(in), &n); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !n.IsSet() || n.String() != want {
+			t.Fatalf("%s decoded to %q", in, n)
+		}
+	}
+	for _, in := range []string{`""`, `null`} {
+		n := NewInt(1)
+		if err := json.Unmarshal([]byte(in), &n); err != nil || n.IsSet() {
+			t.Fatalf("%s decoded to %q, %v", in, n, err)
+		}
+	}
+	var n Number
+	if err := json.Unmarshal([]byte(`"abc"`), &n); err == nil {
+		t.Fatal("expected an error for an invalid number")
+	}
+	if n := NewInt(1234567890123456789); n.Int64() != 1234567890123456789 {
+		t.Fatalf("Int64 = %d", n.Int64())
+	}
+	if n, _ := ParseNumber("12.75"); n.Float64() != 12.75 || n.Int64() != 12 {
+		t.Fatalf("Float64 = %v, Int64 = %d", n.Float64(), n.Int64())
+	}
+}
+
+func TestBoolDecode(t *testing.T) {
+	for in, want := range map[string]bool{`"true"`: true, `"false"`: false, `true`: true, `false`: false} {
+		var b Bool
+		if err := json.Unmarshal([]byte(in), &b); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !b.IsSet() || b.Value() != want {
+			t.Fatalf("%s decoded to %+v", in, b)
+		}
+	}
+	for _, in := range []string{`""`, `null`} {
+		b := NewBool(true)
+		if err := json.Unmarshal([]byte(in), &b); err != nil || b.IsSet() || b.Value() {
+			t.Fatalf("%s decoded to %+v, %v", in, b, err)
+		}
+	}
+	var b Bool
+	if err := json.Unmarshal([]byte(`"yes please"`), &b); err == nil {
+		t.Fatal("expected an error for an invalid bool")
+	}
+}
+
+func TestMultiSelectDecode(t *testing.T) {
+	for in, want := range map[string]string{`"a;b; c"`: "a;b;c", `["a","b"]`: "a;b", `"single"`: "single", `"a;;b"`: "a;b"} {
+		var m MultiSelect
+		if err := json.Unmarshal([]byte(in), &m); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if m.String() != want {
+			t.Fatalf("%s decoded to %q", in, m)
+		}
+	}
+	for _, in := range []string{`""`, `null`} {
+		m := MultiSelect{"x"}
+		if err := json.Unmarshal([]byte(in), &m); err != nil || m != nil {
+			t.Fatalf("%s decoded to %q, %v", in, m, err)
+		}
+	}
+	if m := (MultiSelect{"a", "b"}); !m.Contains("b") || m.Contains("c") {
+		t.Fatal("Contains")
+	}
+}
+
+func TestTypedValuesEncode(t *testing.T) {
+	type props struct {
+		When   DateTime    `json:"when"`
+		Day    Date        `json:"day"`
+		Amount Number      `json:"amount"`
+		Flag   Bool        `json:"flag"`
+		Tags   MultiSelect `json:"tags"`
+		Unset  Number      `json:"unset"`
+	}
+	b, err := MarshalProperties(props{
+		When:   NewDateTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Day:    NewDate(2024, time.March, 15),
+		Amount: NewNumber(12.5),
+		Flag:   NewBool(false),
+		Tags:   MultiSelect{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"amount":"12.5","day":"2024-03-15","flag":"false","tags":"a;b","when":"2024-01-02T03:04:05.000Z"}`
+	if string(b) != want {
+		t.Fatalf("encoded %s, want %s", b, want)
+	}
+}
@@ -41,8 +41,10 @@ type QuoteList struct {
 }
 
 type Quote struct {
-	Id         string          `json:"id,omitempty"`
-	Properties QuoteProperties `json:"properties"`
+	Id                    string             `json:"id,omitempty"`
+	Properties            QuoteProperties    `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
 }
 
 type QuoteProperties struct {
@@ -54,6 +56,18 @@ type QuoteProperties struct {
 	HSTerms          string `json:"hs_terms,omitempty"`
 	HSTitle          string `json:"hs_title,omitempty"`
 	HubSpotOwnerId   string `json:"hubspot_owner_id,omitempty"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p QuoteProperties) MarshalJSON() ([]byte, error) {
+	type properties QuoteProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *QuoteProperties) UnmarshalJSON(b []byte) error {
+	type properties QuoteProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type QuoteReadQuery struct {
@@ -70,6 +84,7 @@ type QuoteBatchReadResults struct {
 	RequestedAt string  `json:"requestedAt"`
 	StartedAt   string  `json:"startedAt"`
 	CompletedAt string  `json:"completedAt"`
+	BatchErrors
 }
 
 type QuoteSearchOptions struct {
@@ -135,19 +150,10 @@ func (z *quotes) Read(ctx context.Context, quoteId string, query *QuoteReadQuery
 
 func (z *quotes) BatchRead(ctx context.Context, options *QuoteBatchReadOptions) (*QuoteBatchReadResults, error) {
 	u := "/crm/v3/objects/quotes/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	qbrr := &QuoteBatchReadResults{}
 
-	err = z.client.do(req, qbrr)
-	if err != nil {
-		return nil, err
-	}
-
-	return qbrr, nil
+	err := z.client.doBatch(ctx, u, options, qbrr)
+	return batchResult(qbrr, err)
 }
 
 func (z *quotes) Search(ctx context.Context, options *QuoteSearchOptions) (*QuoteSearchResults, error) {
@@ -0,0 +1,173 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimitMax and DefaultRateLimitInterval match the burst limit of a private app on the lowest tier.
+	// The limiter re-seeds itself from the X-HubSpot-RateLimit-* headers of the first response.
+	DefaultRateLimitMax      = 100
+	DefaultRateLimitInterval = 10 * time.Second
+
+	// The search endpoints have their own per second limit. The search limiter is still updated from the
+	// rate limit headers of search responses, should HubSpot return any.
+	DefaultSearchRateLimitMax      = 5
+	DefaultSearchRateLimitInterval = time.Second
+
+	// dailyLimitRecheck is how long calls are refused after HubSpot reported the daily quota as exhausted,
+	// after which a single call is let through to observe whether the quota has been reset. The other
+	// calls are refused for another dailyLimitRecheck unless the response of that call reports a new quota.
+	dailyLimitRecheck = time.Minute
+)
+
+var ErrDailyRateLimitExceeded = errors.New("hubspot: daily rate limit exceeded")
+
+// RateLimitBudget is a snapshot of the quota known to a RateLimiter.
+type RateLimitBudget struct {
+	Max       int
+	Interval  time.Duration
+	Remaining int
+	// DailyRemaining is -1 until HubSpot has reported it.
+	DailyRemaining int
+}
+
+// RateLimiter is a token bucket which blocks callers before HubSpot's rate limit is reached.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	mu             sync.Mutex
+	max            int
+	interval       time.Duration
+	tokens         float64
+	last           time.Time
+	dailyRemaining int
+	dailySeenAt    time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing max calls per interval. A max below 1 is raised to 1
+// and an interval which is not positive falls back to DefaultRateLimitInterval.
+func NewRateLimiter(max int, interval time.Duration) *RateLimiter {
+	if max < 1 {
+		max = 1
+	}
+	if interval <= 0 {
+		interval = DefaultRateLimitInterval
+	}
+	return &RateLimiter{
+		max:            max,
+		interval:       interval,
+		tokens:         float64(max),
+		last:           time.Now(),
+		dailyRemaining: -1,
+	}
+}
+
+// SetRateLimiter replaces the limiter used for all calls except search. Passing nil disables it.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.limiter = limiter
+}
+
+// SetSearchRateLimiter replaces the limiter used for the /search endpoints. Passing nil disables it.
+func (c *Client) SetSearchRateLimiter(limiter *RateLimiter) {
+	c.searchLimiter = limiter
+}
+
+// RateLimitBudget returns the remaining budget of the general rate limiter.
+func (c *Client) RateLimitBudget() RateLimitBudget {
+	if c.limiter == nil {
+		return RateLimitBudget{DailyRemaining: -1}
+	}
+	return c.limiter.Budget()
+}
+
+// Wait blocks until a call may be made or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.dailyRemaining == 0 {
+			if time.Since(l.dailySeenAt) < dailyLimitRecheck {
+				l.mu.Unlock()
+				return ErrDailyRateLimitExceeded
+			}
+			// Let this call through as the probe and refuse the others until its response updates the quota
+			l.dailySeenAt = time.Now()
+		}
+		l.refill()
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate())
+		l.mu.Unlock()
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Update seeds the limiter from the X-HubSpot-RateLimit-* headers of a response.
+func (l *RateLimiter) Update(h http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	max, maxErr := strconv.Atoi(h.Get(HeaderRateLimitMax))
+	ms, msErr := strconv.Atoi(h.Get(HeaderRateLimitIntervalMilliseconds))
+	if maxErr == nil && msErr == nil && max > 0 && ms > 0 {
+		l.refill()
+		l.max = max
+		l.interval = time.Duration(ms) * time.Millisecond
+	}
+	if remaining, err := strconv.Atoi(h.Get(HeaderRateLimitRemaining)); err == nil {
+		l.refill()
+		l.tokens = math.Min(l.tokens, float64(remaining))
+	}
+	if daily, err := strconv.Atoi(h.Get(HeaderRateLimitDailyRemaining)); err == nil {
+		l.dailyRemaining = daily
+		l.dailySeenAt = time.Now()
+	}
+}
+
+// Budget returns a snapshot of the current quota.
+func (l *RateLimiter) Budget() RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	return RateLimitBudget{
+		Max:            l.max,
+		Interval:       l.interval,
+		Remaining:      int(l.tokens),
+		DailyRemaining: l.dailyRemaining,
+	}
+}
+
+// rate returns the number of tokens added per nanosecond
+func (l *RateLimiter) rate() float64 {
+	return float64(l.max) / float64(l.interval)
+}
+
+func (l *RateLimiter) refill() {
+	now := time.Now()
+	l.tokens = math.Min(float64(l.max), l.tokens+float64(now.Sub(l.last))*l.rate())
+	l.last = now
+}
+
+// limiterFor returns the limiter which applies to req, if any.
+func (c *Client) limiterFor(req *http.Request) *RateLimiter {
+	if isSearchRequest(req) {
+		return c.searchLimiter
+	}
+	return c.limiter
+}
+
+func isSearchRequest(req *http.Request) bool {
+	return req.Method == "POST" && strings.HasSuffix(req.URL.Path, "/search")
+}
@@ -0,0 +1,151 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurstThenWait(t *testing.T) {
+	l := NewRateLimiter(5, 100*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Fatalf("burst of 5 took %s", elapsed)
+	}
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Fatalf("6th call after %s, want it to wait for a token", elapsed)
+	}
+}
+
+func TestRateLimiterWaitHonorsContext(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRateLimiterClampsInvalidArguments(t *testing.T) {
+	for _, args := range []struct {
+		max      int
+		interval time.Duration
+	}{{0, time.Second}, {-1, time.Second}, {10, 0}, {10, -time.Second}, {0, 0}} {
+		l := NewRateLimiter(args.max, args.interval)
+		budget := l.Budget()
+		if budget.Max < 1 || budget.Interval <= 0 {
+			t.Fatalf("NewRateLimiter(%d, %s) budget = %+v", args.max, args.interval, budget)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("NewRateLimiter(%d, %s).Wait = %v", args.max, args.interval, err)
+		}
+		cancel()
+	}
+}
+
+func TestRateLimiterUpdateFromHeaders(t *testing.T) {
+	l := NewRateLimiter(100, 10*time.Second)
+	h := http.Header{}
+	h.Set(HeaderRateLimitMax, "190")
+	h.Set(HeaderRateLimitIntervalMilliseconds, "10000")
+	h.Set(HeaderRateLimitRemaining, "3")
+	h.Set(HeaderRateLimitDailyRemaining, "5000")
+	l.Update(h)
+
+	budget := l.Budget()
+	if budget.Max != 190 || budget.Interval != 10*time.Second || budget.Remaining != 3 || budget.DailyRemaining != 5000 {
+		t.Fatalf("budget = %+v", budget)
+	}
+}
+
+func TestRateLimiterDailyLimitExhausted(t *testing.T) {
+	l := NewRateLimiter(100, 10*time.Second)
+	h := http.Header{}
+	h.Set(HeaderRateLimitDailyRemaining, "0")
+	l.Update(h)
+
+	if err := l.Wait(context.Background()); !errors.Is(err, ErrDailyRateLimitExceeded) {
+		t.Fatalf("err = %v, want ErrDailyRateLimitExceeded", err)
+	}
+}
+
+func TestRateLimiterDailyLimitLetsOneProbeThrough(t *testing.T) {
+	l := NewRateLimiter(100, 10*time.Second)
+	h := http.Header{}
+	h.Set(HeaderRateLimitDailyRemaining, "0")
+	l.Update(h)
+	l.dailySeenAt = time.Now().Add(-dailyLimitRecheck)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("probe call refused: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); !errors.Is(err, ErrDailyRateLimitExceeded) {
+			t.Fatalf("call %d while the probe is in flight: err = %v, want ErrDailyRateLimitExceeded", i, err)
+		}
+	}
+
+	h.Set(HeaderRateLimitDailyRemaining, "250000")
+	l.Update(h)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("call after the quota was reset: %v", err)
+	}
+}
+
+func TestSearchLimiterUpdatedFromResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderRateLimitMax, "4")
+		w.Header().Set(HeaderRateLimitIntervalMilliseconds, "1000")
+		w.Write([]byte(`{"results":[]}`))
+	})
+	search := NewRateLimiter(DefaultSearchRateLimitMax, DefaultSearchRateLimitInterval)
+	client.SetSearchRateLimiter(search)
+
+	if _, err := client.Contacts.Search(context.Background(), &ContactSearchOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if max := search.Budget().Max; max != 4 {
+		t.Fatalf("search limiter max = %d, want 4 from the response headers", max)
+	}
+}
+
+func TestDeveloperRequestDoesNotUpdateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderRateLimitMax, "10")
+		w.Header().Set(HeaderRateLimitIntervalMilliseconds, "1000")
+		w.Header().Set(HeaderRateLimitRemaining, "0")
+		w.Header().Set(HeaderRateLimitDailyRemaining, "0")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	limiter := NewRateLimiter(100, 10*time.Second)
+	client, err := NewDeveloperClient("key", WithBaseURL(srv.URL), WithRateLimiter(limiter), WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Webhooks.ReadSettings(context.Background(), "123"); err != nil {
+		t.Fatal(err)
+	}
+	if budget := limiter.Budget(); budget.Max != 100 || budget.Remaining < 98 || budget.DailyRemaining != -1 {
+		t.Fatalf("budget = %+v, want it untouched by the developer account's headers", budget)
+	}
+}
@@ -0,0 +1,179 @@
+package hubspot
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// RawProperties holds every property value of an object keyed by its internal name, including custom
+// properties which have no field on the typed properties struct.
+//
+// When a properties struct is encoded, RawProperties only contributes the properties the struct has no field
+// for, so custom properties survive a Read followed by an Update. Decoding fills both the typed fields and
+// RawProperties, so Raw also holds properties such as dealname which have a typed field; setting such an
+// entry has no effect on an update, the typed field is always sent instead. Change the typed field.
+// Calls, emails, meetings, notes and tasks are read and written with different properties structs; use
+// their CreateOrUpdateProperties method to carry Raw over instead of copying fields by hand.
+type RawProperties map[string]string
+
+// Get returns the value of the named property, or an empty string when it is not set.
+func (r RawProperties) Get(name string) string {
+	return r[name]
+}
+
+// Lookup returns the value of the named property and whether it is set.
+func (r RawProperties) Lookup(name string) (string, bool) {
+	v, ok := r[name]
+	return v, ok
+}
+
+// Set sets the value of the named property, allocating the map if needed.
+func (r *RawProperties) Set(name string, value string) {
+	if *r == nil {
+		*r = make(RawProperties)
+	}
+	(*r)[name] = value
+}
+
+// Delete removes the named property.
+func (r RawProperties) Delete(name string) {
+	delete(r, name)
+}
+
+// readOnlyProperties are computed by HubSpot and rejected when sent in a create or update.
+var readOnlyProperties = map[string]bool{
+	"createdate":          true,
+	"hs_createdate":       true,
+	"lastmodifieddate":    true,
+	"hs_lastmodifieddate": true,
+	"hs_object_id":        true,
+}
+
+// writableRawProperties returns a copy of raw without the read only properties.
+func writableRawProperties(raw RawProperties) RawProperties {
+	if raw == nil {
+		return nil
+	}
+	writable := make(RawProperties, len(raw))
+	for k, v := range raw {
+		if !readOnlyProperties[k] {
+			writable[k] = v
+		}
+	}
+	return writable
+}
+
+// decodeRawProperties decodes every non-null property of a JSON object. Numbers and booleans are kept in
+// their JSON text form.
+func decodeRawProperties(b []byte) (RawProperties, error) {
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	raw := make(RawProperties, len(values))
+	for k, v := range values {
+		v = bytes.TrimSpace(v)
+		if bytes.Equal(v, []byte("null")) {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			s = string(v)
+		}
+		raw[k] = s
+	}
+	return raw, nil
+}
+
+// MarshalPropertiesWithRaw encodes typed, a properties struct converted to a type without a MarshalJSON
+// method, and adds the entries of raw which typed has no field for. Typed values which are not set encode
+// as null and are left out. Properties structs with a Raw field use it from their MarshalJSON method:
+//
+//	func (p DealProperties) MarshalJSON() ([]byte, error) {
+//		type properties DealProperties
+//		return MarshalPropertiesWithRaw(properties(p), p.Raw)
+//	}
+func MarshalPropertiesWithRaw[T any](typed T, raw RawProperties) ([]byte, error) {
+	b, err := json.Marshal(typed)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]json.RawMessage{}
+	if err = json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	for k, v := range values {
+		if bytes.Equal(v, jsonNull) {
+			delete(values, k)
+		}
+	}
+	known := knownProperties(reflect.TypeOf(typed))
+	for k, v := range raw {
+		if known[k] {
+			continue
+		}
+		if values[k], err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalPropertiesWithRaw decodes b into typed, a properties struct converted to a type without an
+// UnmarshalJSON method, and stores every property of b in raw, see RawProperties. Properties structs
+// with a Raw field use it from their UnmarshalJSON method:
+//
+//	func (p *DealProperties) UnmarshalJSON(b []byte) error {
+//		type properties DealProperties
+//		return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+//	}
+func UnmarshalPropertiesWithRaw[T any](b []byte, typed *T, raw *RawProperties) error {
+	if err := json.Unmarshal(b, typed); err != nil {
+		return err
+	}
+	decoded, err := decodeRawProperties(b)
+	if err != nil {
+		return err
+	}
+	*raw = decoded
+	return nil
+}
+
+// MarshalProperties encodes a properties struct, leaving out the typed values which are not set.
+// Properties structs with DateTime, Date, Number or Bool fields but no Raw field use it from their
+// MarshalJSON method.
+func MarshalProperties(v interface{}) ([]byte, error) {
+	return MarshalPropertiesWithRaw(v, nil)
+}
+
+var knownPropertiesCache sync.Map
+
+// knownProperties returns the JSON names of the fields of a properties struct.
+func knownProperties(t reflect.Type) map[string]bool {
+	if known, ok := knownPropertiesCache.Load(t); ok {
+		return known.(map[string]bool)
+	}
+	known := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+	knownPropertiesCache.Store(t, known)
+	return known
+}
+
+// UnmarshalJSON decodes a properties object leniently, see decodeRawProperties.
+func (r *RawProperties) UnmarshalJSON(b []byte) error {
+	raw, err := decodeRawProperties(b)
+	if err != nil {
+		return err
+	}
+	*r = raw
+	return nil
+}
@@ -0,0 +1,98 @@
+package hubspot
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRawPropertiesRoundTrip(t *testing.T) {
+	in := []byte(`{"dealname":"Deal","createdbyapi":"yes","custom_score":"42","hs_object_id":"7"}`)
+	var p DealProperties
+	if err := json.Unmarshal(in, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.CreatedByApi != "yes" || p.Raw.Get("custom_score") != "42" {
+		t.Fatalf("decoded %+v", p)
+	}
+
+	p.DealName = "Renamed"
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	json.Unmarshal(out, &values)
+	if values["dealname"] != "Renamed" || values["custom_score"] != "42" || values["createdbyapi"] != "yes" {
+		t.Fatalf("encoded %s", out)
+	}
+}
+
+func TestCreateOrUpdatePropertiesKeepsRaw(t *testing.T) {
+	in := []byte(`{"hs_note_body":"body","custom_flag":"true","createdate":"2024-01-01T00:00:00Z","hs_lastmodifieddate":"2024-01-02T00:00:00Z","hs_object_id":"1"}`)
+	var read NoteProperties
+	if err := json.Unmarshal(in, &read); err != nil {
+		t.Fatal(err)
+	}
+
+	update := read.CreateOrUpdateProperties()
+	update.HsNoteBody = "changed"
+	out, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	json.Unmarshal(out, &values)
+	if values["hs_note_body"] != "changed" || values["custom_flag"] != "true" {
+		t.Fatalf("encoded %s", out)
+	}
+	for _, readOnly := range []string{"createdate", "hs_lastmodifieddate", "hs_object_id"} {
+		if _, ok := values[readOnly]; ok {
+			t.Fatalf("read only %s sent in %s", readOnly, out)
+		}
+	}
+	if _, ok := read.Raw["createdate"]; !ok {
+		t.Fatal("CreateOrUpdateProperties modified the Raw of the read properties")
+	}
+}
+
+func TestRawPropertiesTypedFieldTakesPrecedence(t *testing.T) {
+	p := DealProperties{DealName: "Typed"}
+	p.Raw.Set("dealname", "Raw")
+	p.Raw.Set("custom_score", "42")
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	json.Unmarshal(out, &values)
+	if values["dealname"] != "Typed" || values["custom_score"] != "42" {
+		t.Fatalf("encoded %s, want the typed dealname and the custom property", out)
+	}
+}
+
+func TestPropertiesWithRawHelpers(t *testing.T) {
+	type properties struct {
+		Name   string `json:"name,omitempty"`
+		Amount Number `json:"amount,omitempty"`
+	}
+	var p properties
+	var raw RawProperties
+	if err := UnmarshalPropertiesWithRaw([]byte(`{"name":"n","amount":"12","custom":null,"other":"x"}`), &p, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "n" || raw.Get("other") != "x" || raw.Get("amount") != "12" {
+		t.Fatalf("decoded %+v, raw %v", p, raw)
+	}
+	if _, ok := raw.Lookup("custom"); ok {
+		t.Fatal("null property kept in raw")
+	}
+
+	out, err := MarshalPropertiesWithRaw(properties{Name: "m"}, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"name":"m","other":"x"}` {
+		t.Fatalf("encoded %s", out)
+	}
+}
@@ -0,0 +1,178 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderRetryAfter                    = "Retry-After"
+	HeaderRateLimitDaily                = "X-HubSpot-RateLimit-Daily"
+	HeaderRateLimitDailyRemaining       = "X-HubSpot-RateLimit-Daily-Remaining"
+	HeaderRateLimitIntervalMilliseconds = "X-HubSpot-RateLimit-Interval-Milliseconds"
+	HeaderRateLimitMax                  = "X-HubSpot-RateLimit-Max"
+	HeaderRateLimitRemaining            = "X-HubSpot-RateLimit-Remaining"
+)
+
+// RetryPolicy controls how the Client retries requests that fail with a 429, a 5xx or a transport error.
+// Requests are only retried after a 5xx or transport error when they are idempotent, unless
+// RetryNonIdempotent is set or the request context was created with WithNonIdempotentRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry, doubled on every following attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the computed backoff as well as any server provided Retry-After.
+	MaxBackoff time.Duration
+	// Jitter randomises each backoff by up to the given fraction (0 to 1) in either direction.
+	Jitter float64
+	// RetryNonIdempotent allows POST and PATCH calls such as Create to be retried after a 5xx or transport error.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is used by NewClient and NewClientWithTokenSource unless another policy is set with
+// WithRetryPolicy or SetRetryPolicy. NewHubspotClient and NewHubspotClientFromHttpClient do not retry.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	Jitter:      0.2,
+}
+
+type nonIdempotentRetryKey struct{}
+
+// WithNonIdempotentRetry returns a context that opts the calls made with it into being retried
+// even when they are not idempotent, e.g. Create or BatchCreate.
+func WithNonIdempotentRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonIdempotentRetryKey{}, true)
+}
+
+// SetRetryPolicy replaces the retry policy of the Client. Passing nil disables retries.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retry = policy
+}
+
+// backoff returns how long to wait before the given retry attempt (starting at 1).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := time.Duration(float64(p.BaseBackoff) * math.Pow(2, float64(attempt-1)))
+	if p.MaxBackoff > 0 && (d > p.MaxBackoff || d <= 0) {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// shouldRetry decides whether the request should be attempted again and how long to wait before doing so.
+func (p *RetryPolicy) shouldRetry(req *http.Request, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || req.Context().Err() != nil {
+		return 0, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return 0, false
+	}
+	if errors.Is(err, ErrDailyRateLimitExceeded) {
+		return 0, false
+	}
+
+	errResponse := &ErrorResponse{}
+	if !errors.As(err, &errResponse) {
+		// Transport level failure, the request may or may not have reached HubSpot.
+		return p.backoff(attempt), p.canRetryUnsafe(req)
+	}
+
+	switch {
+	case errResponse.StatusCode == http.StatusTooManyRequests:
+		// HubSpot rejects rate limited calls before processing them, so any method is safe to replay.
+		if errResponse.Header.Get(HeaderRateLimitDailyRemaining) == "0" {
+			return 0, false
+		}
+		return p.waitFromHeaders(errResponse.Header, attempt), true
+	case errResponse.StatusCode == http.StatusBadGateway,
+		errResponse.StatusCode == http.StatusServiceUnavailable,
+		errResponse.StatusCode == http.StatusGatewayTimeout,
+		errResponse.StatusCode == http.StatusInternalServerError:
+		return p.waitFromHeaders(errResponse.Header, attempt), p.canRetryUnsafe(req)
+	}
+	return 0, false
+}
+
+func (p *RetryPolicy) canRetryUnsafe(req *http.Request) bool {
+	if isIdempotent(req) || p.RetryNonIdempotent {
+		return true
+	}
+	optIn, _ := req.Context().Value(nonIdempotentRetryKey{}).(bool)
+	return optIn
+}
+
+// waitFromHeaders prefers Retry-After, then the HubSpot rate limit window, then exponential backoff.
+func (p *RetryPolicy) waitFromHeaders(h http.Header, attempt int) time.Duration {
+	wait := p.backoff(attempt)
+	if ra := h.Get(HeaderRetryAfter); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil {
+			wait = time.Duration(secs) * time.Second
+		} else if t, err := http.ParseTime(ra); err == nil {
+			wait = time.Until(t)
+		}
+	} else if h.Get(HeaderRateLimitRemaining) == "0" {
+		if ms, err := strconv.Atoi(h.Get(HeaderRateLimitIntervalMilliseconds)); err == nil && ms > 0 {
+			wait = time.Duration(ms) * time.Millisecond
+		}
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+// isIdempotent reports whether replaying req cannot create duplicate data. Search and batch read
+// are POST endpoints which only read data.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	case "POST":
+		return strings.HasSuffix(req.URL.Path, "/search") || strings.HasSuffix(req.URL.Path, "/batch/read")
+	}
+	return false
+}
+
+// rewindRequest returns a copy of req with a fresh body so it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,182 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var fastRetry = &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+func TestRetryTooManyRequestsHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	var retriedAt time.Time
+	var firstAt time.Time
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			firstAt = time.Now()
+			w.Header().Set(HeaderRetryAfter, "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		retriedAt = time.Now()
+		w.Write([]byte(`{"id":"1"}`))
+	}, WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond, MaxBackoff: 2 * time.Second}))
+
+	contact, err := client.Contacts.Read(context.Background(), nil, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contact.Id != "1" || calls != 2 {
+		t.Fatalf("got contact %q after %d calls", contact.Id, calls)
+	}
+	if wait := retriedAt.Sub(firstAt); wait < 900*time.Millisecond {
+		t.Fatalf("retried after %s, want the 1s Retry-After", wait)
+	}
+}
+
+func TestRetryAfterCappedByMaxBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Millisecond, MaxBackoff: time.Second}
+	h := http.Header{}
+	h.Set(HeaderRetryAfter, "120")
+	if wait := p.waitFromHeaders(h, 1); wait != time.Second {
+		t.Fatalf("wait = %s, want 1s", wait)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		var calls int32
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(status)
+				return
+			}
+			w.Write([]byte(`{"id":"1"}`))
+		}, WithRetryPolicy(fastRetry))
+
+		if _, err := client.Contacts.Read(context.Background(), nil, "1"); err != nil {
+			t.Fatalf("status %d: %v", status, err)
+		}
+		if calls != 3 {
+			t.Fatalf("status %d: %d calls, want 3", status, calls)
+		}
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(fastRetry))
+
+	_, err := client.Contacts.Read(context.Background(), nil, "1")
+	errResponse := &ErrorResponse{}
+	if !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("err = %v, want a 503 *ErrorResponse", err)
+	}
+	if calls != 3 {
+		t.Fatalf("%d calls, want 3", calls)
+	}
+}
+
+func TestRetryDoesNotReplayNonIdempotentPost(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetryPolicy(fastRetry))
+
+	if _, err := client.Contacts.Create(context.Background(), &ContactCreateOrUpdateOptions{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Fatalf("%d calls, want 1", calls)
+	}
+}
+
+func TestRetryReplaysPostBody(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}, WithRetryPolicy(fastRetry))
+
+	ctx := WithNonIdempotentRetry(context.Background())
+	options := &ContactCreateOrUpdateOptions{Properties: ContactProperties{Email: "a@example.com"}}
+	if _, err := client.Contacts.Create(ctx, options); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 2 || bodies[0] == "" || bodies[0] != bodies[1] {
+		t.Fatalf("bodies = %q, want the same body twice", bodies)
+	}
+}
+
+func TestRetryStopsWhenContextCancelled(t *testing.T) {
+	var calls int32
+	ctx, cancel := context.WithCancel(context.Background())
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(&RetryPolicy{MaxAttempts: 5, BaseBackoff: time.Minute}))
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := client.Contacts.Read(ctx, nil, "1")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Fatalf("%d calls, want 1", calls)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatal("waited out the backoff despite the cancelled context")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestLegacyConstructorsDoNotRetry(t *testing.T) {
+	var calls int32
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("")), Request: r}, nil
+	})}
+	client, err := NewHubspotClientFromHttpClient("token", httpClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.limiter != nil || client.searchLimiter != nil {
+		t.Error("legacy constructor set a client-side rate limiter")
+	}
+
+	if _, err := client.Contacts.Read(context.Background(), nil, "1"); err == nil {
+		t.Fatal("expected the 503 to be returned")
+	}
+	if calls != 1 {
+		t.Fatalf("%d calls, want the request sent once", calls)
+	}
+
+	client, err = NewHubspotClient("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.retry != nil || client.limiter != nil || client.searchLimiter != nil {
+		t.Error("NewHubspotClient set a retry policy or rate limiter")
+	}
+}
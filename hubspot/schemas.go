@@ -0,0 +1,195 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+)
+
+type Schemas interface {
+	List(ctx context.Context, query *SchemaListQuery) (*SchemaList, error)
+	Create(ctx context.Context, options *SchemaCreateOptions) (*Schema, error)
+	Read(ctx context.Context, objectType ObjectType) (*Schema, error)
+	Update(ctx context.Context, objectType ObjectType, options *SchemaUpdateOptions) (*Schema, error)
+	Delete(ctx context.Context, objectType ObjectType, query *SchemaDeleteQuery) error
+	CreateAssociation(ctx context.Context, objectType ObjectType, options *SchemaAssociationCreateOptions) (*SchemaAssociation, error)
+	DeleteAssociation(ctx context.Context, objectType ObjectType, associationId string) error
+}
+
+type schemas struct {
+	client *Client
+}
+
+type SchemaListQuery struct {
+	Archived bool `url:"archived,omitempty"`
+}
+
+type SchemaList struct {
+	Results []Schema `json:"results"`
+}
+
+type Schema struct {
+	Id                         string              `json:"id"`
+	ObjectTypeId               ObjectType          `json:"objectTypeId"`
+	FullyQualifiedName         string              `json:"fullyQualifiedName"`
+	Name                       string              `json:"name"`
+	Description                string              `json:"description,omitempty"`
+	Labels                     SchemaLabels        `json:"labels"`
+	PrimaryDisplayProperty     string              `json:"primaryDisplayProperty,omitempty"`
+	SecondaryDisplayProperties []string            `json:"secondaryDisplayProperties,omitempty"`
+	RequiredProperties         []string            `json:"requiredProperties"`
+	SearchableProperties       []string            `json:"searchableProperties,omitempty"`
+	Properties                 []Property          `json:"properties"`
+	Associations               []SchemaAssociation `json:"associations"`
+	MetaType                   string              `json:"metaType,omitempty"`
+	CreatedByUserId            int64               `json:"createdByUserId,omitempty"`
+	UpdatedByUserId            int64               `json:"updatedByUserId,omitempty"`
+	CreatedAt                  string              `json:"createdAt,omitempty"`
+	UpdatedAt                  string              `json:"updatedAt,omitempty"`
+	Archived                   bool                `json:"archived"`
+}
+
+type SchemaLabels struct {
+	Singular string `json:"singular,omitempty"`
+	Plural   string `json:"plural,omitempty"`
+}
+
+type SchemaAssociation struct {
+	Id               string     `json:"id"`
+	Name             string     `json:"name,omitempty"`
+	FromObjectTypeId ObjectType `json:"fromObjectTypeId"`
+	ToObjectTypeId   ObjectType `json:"toObjectTypeId"`
+	CreatedAt        string     `json:"createdAt,omitempty"`
+	UpdatedAt        string     `json:"updatedAt,omitempty"`
+}
+
+type SchemaCreateOptions struct {
+	Name                       string                  `json:"name"`
+	Description                string                  `json:"description,omitempty"`
+	Labels                     SchemaLabels            `json:"labels"`
+	PrimaryDisplayProperty     string                  `json:"primaryDisplayProperty,omitempty"`
+	SecondaryDisplayProperties []string                `json:"secondaryDisplayProperties,omitempty"`
+	RequiredProperties         []string                `json:"requiredProperties"`
+	SearchableProperties       []string                `json:"searchableProperties,omitempty"`
+	Properties                 []PropertyCreateOptions `json:"properties"`
+	AssociatedObjects          []string                `json:"associatedObjects"`
+}
+
+type SchemaUpdateOptions struct {
+	Description                string        `json:"description,omitempty"`
+	ClearDescription           bool          `json:"clearDescription,omitempty"`
+	Labels                     *SchemaLabels `json:"labels,omitempty"`
+	PrimaryDisplayProperty     string        `json:"primaryDisplayProperty,omitempty"`
+	SecondaryDisplayProperties []string      `json:"secondaryDisplayProperties,omitempty"`
+	RequiredProperties         []string      `json:"requiredProperties,omitempty"`
+	SearchableProperties       []string      `json:"searchableProperties,omitempty"`
+	Restorable                 bool          `json:"restorable,omitempty"`
+}
+
+type SchemaDeleteQuery struct {
+	// Archived permanently deletes a schema which has already been archived.
+	Archived bool `url:"archived,omitempty"`
+}
+
+type SchemaAssociationCreateOptions struct {
+	FromObjectTypeId ObjectType `json:"fromObjectTypeId"`
+	ToObjectTypeId   ObjectType `json:"toObjectTypeId"`
+	Name             string     `json:"name,omitempty"`
+}
+
+func (z *schemas) List(ctx context.Context, query *SchemaListQuery) (*SchemaList, error) {
+	u := "/crm/v3/schemas"
+	req, err := z.client.newHttpRequest(ctx, "GET", u, query)
+	if err != nil {
+		return nil, err
+	}
+
+	sl := &SchemaList{}
+
+	err = z.client.do(req, sl)
+	if err != nil {
+		return nil, err
+	}
+	return sl, nil
+}
+
+func (z *schemas) Create(ctx context.Context, options *SchemaCreateOptions) (*Schema, error) {
+	u := "/crm/v3/schemas"
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+
+	err = z.client.do(req, schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (z *schemas) Read(ctx context.Context, objectType ObjectType) (*Schema, error) {
+	u := fmt.Sprintf("/crm/v3/schemas/%s", objectType)
+	req, err := z.client.newHttpRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+
+	err = z.client.do(req, schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (z *schemas) Update(ctx context.Context, objectType ObjectType, options *SchemaUpdateOptions) (*Schema, error) {
+	u := fmt.Sprintf("/crm/v3/schemas/%s", objectType)
+	req, err := z.client.newHttpRequest(ctx, "PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+
+	err = z.client.do(req, schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (z *schemas) Delete(ctx context.Context, objectType ObjectType, query *SchemaDeleteQuery) error {
+	u := fmt.Sprintf("/crm/v3/schemas/%s", objectType)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, query)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
+
+func (z *schemas) CreateAssociation(ctx context.Context, objectType ObjectType, options *SchemaAssociationCreateOptions) (*SchemaAssociation, error) {
+	u := fmt.Sprintf("/crm/v3/schemas/%s/associations", objectType)
+	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sa := &SchemaAssociation{}
+
+	err = z.client.do(req, sa)
+	if err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+func (z *schemas) DeleteAssociation(ctx context.Context, objectType ObjectType, associationId string) error {
+	u := fmt.Sprintf("/crm/v3/schemas/%s/associations/%s", objectType, associationId)
+	req, err := z.client.newHttpRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
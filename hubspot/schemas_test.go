@@ -0,0 +1,126 @@
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const schemaJSON = `{
+	"id": "1234567",
+	"objectTypeId": "2-1234567",
+	"fullyQualifiedName": "p123_subscriptions",
+	"name": "subscriptions",
+	"labels": {"singular": "Subscription", "plural": "Subscriptions"},
+	"primaryDisplayProperty": "name",
+	"requiredProperties": ["name"],
+	"properties": [{"name": "name", "label": "Name", "type": "string", "fieldType": "text"}],
+	"associations": [{"id": "77", "fromObjectTypeId": "2-1234567", "toObjectTypeId": "0-1", "name": "subscription_to_contact"}],
+	"archived": false
+}`
+
+func TestSchemasRequests(t *testing.T) {
+	client, s := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/schemas":                              {Body: `{"results":[` + schemaJSON + `]}`},
+		"POST /crm/v3/schemas":                             {Status: http.StatusCreated, Body: schemaJSON},
+		"GET /crm/v3/schemas/2-1234567":                    {Body: schemaJSON},
+		"PATCH /crm/v3/schemas/2-1234567":                  {Body: schemaJSON},
+		"DELETE /crm/v3/schemas/2-1234567":                 {Status: http.StatusNoContent},
+		"POST /crm/v3/schemas/2-1234567/associations":      {Status: http.StatusCreated, Body: `{"id":"78","fromObjectTypeId":"2-1234567","toObjectTypeId":"0-2"}`},
+		"DELETE /crm/v3/schemas/2-1234567/associations/78": {Status: http.StatusNoContent},
+	})
+	ctx := context.Background()
+	objectType := ObjectType("2-1234567")
+
+	tests := []struct {
+		name   string
+		call   func() error
+		method string
+		path   string
+		query  string
+		body   string
+	}{
+		{"list", func() error {
+			list, err := client.Schemas.List(ctx, &SchemaListQuery{Archived: true})
+			if err == nil && (len(list.Results) != 1 || list.Results[0].ObjectTypeId != objectType) {
+				t.Errorf("list = %+v", list)
+			}
+			return err
+		}, "GET", "/crm/v3/schemas", "archived=true", ""},
+		{"create", func() error {
+			_, err := client.Schemas.Create(ctx, &SchemaCreateOptions{
+				Name:                   "subscriptions",
+				Labels:                 SchemaLabels{Singular: "Subscription", Plural: "Subscriptions"},
+				PrimaryDisplayProperty: "name",
+				RequiredProperties:     []string{"name"},
+				Properties:             []PropertyCreateOptions{{Name: "name", Label: "Name", Type: PropertyTypeString, FieldType: FieldTypeText}},
+				AssociatedObjects:      []string{"CONTACT"},
+			})
+			return err
+		}, "POST", "/crm/v3/schemas", "", `{
+			"name": "subscriptions",
+			"labels": {"singular": "Subscription", "plural": "Subscriptions"},
+			"primaryDisplayProperty": "name",
+			"requiredProperties": ["name"],
+			"properties": [{"name": "name", "label": "Name", "type": "string", "fieldType": "text"}],
+			"associatedObjects": ["CONTACT"]
+		}`},
+		{"read", func() error {
+			_, err := client.Schemas.Read(ctx, objectType)
+			return err
+		}, "GET", "/crm/v3/schemas/2-1234567", "", ""},
+		{"update", func() error {
+			_, err := client.Schemas.Update(ctx, objectType, &SchemaUpdateOptions{Labels: &SchemaLabels{Singular: "Plan"}, ClearDescription: true})
+			return err
+		}, "PATCH", "/crm/v3/schemas/2-1234567", "", `{"labels": {"singular": "Plan"}, "clearDescription": true}`},
+		{"delete", func() error {
+			return client.Schemas.Delete(ctx, objectType, &SchemaDeleteQuery{Archived: true})
+		}, "DELETE", "/crm/v3/schemas/2-1234567", "archived=true", ""},
+		{"create association", func() error {
+			association, err := client.Schemas.CreateAssociation(ctx, objectType, &SchemaAssociationCreateOptions{FromObjectTypeId: objectType, ToObjectTypeId: ObjectTypeIdCompanies})
+			if err == nil && (association.Id != "78" || association.ToObjectTypeId != ObjectTypeIdCompanies) {
+				t.Errorf("association = %+v", association)
+			}
+			return err
+		}, "POST", "/crm/v3/schemas/2-1234567/associations", "", `{"fromObjectTypeId": "2-1234567", "toObjectTypeId": "0-2"}`},
+		{"delete association", func() error {
+			return client.Schemas.DeleteAssociation(ctx, objectType, "78")
+		}, "DELETE", "/crm/v3/schemas/2-1234567/associations/78", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			r := s.last(t)
+			if r.Method != tt.method || r.Path != tt.path || r.Query.Encode() != tt.query {
+				t.Errorf("sent %s %s?%s, want %s %s?%s", r.Method, r.Path, r.Query.Encode(), tt.method, tt.path, tt.query)
+			}
+			if tt.body != "" {
+				assertJSON(t, r.Body, tt.body)
+			} else if len(r.Body) != 0 {
+				t.Errorf("unexpected body %s", r.Body)
+			}
+		})
+	}
+}
+
+func TestSchemaDecoding(t *testing.T) {
+	client, _ := newRouteClient(t, map[string]testResponse{
+		"GET /crm/v3/schemas/p123_subscriptions": {Body: schemaJSON},
+	})
+
+	schema, err := client.Schemas.Read(context.Background(), "p123_subscriptions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.ObjectTypeId != "2-1234567" || schema.FullyQualifiedName != "p123_subscriptions" || schema.Labels.Plural != "Subscriptions" {
+		t.Errorf("schema = %+v", schema)
+	}
+	if len(schema.Properties) != 1 || schema.Properties[0].Type != PropertyTypeString {
+		t.Errorf("properties = %+v", schema.Properties)
+	}
+	if len(schema.Associations) != 1 || schema.Associations[0].ToObjectTypeId.Name() != ObjectTypeContacts {
+		t.Errorf("associations = %+v", schema.Associations)
+	}
+}
@@ -0,0 +1,244 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// MaxSearchResults is the number of results HubSpot returns for a single search query across all of its pages.
+const MaxSearchResults = 10000
+
+var (
+	ErrSearchWindowExhausted = errors.New("hubspot: more than 10000 search results share the same window value")
+	ErrSearchAllSort         = errors.New("hubspot: SearchAll sorts by its window property and cannot apply another sort")
+)
+
+type SearchWindowBy int
+
+const (
+	// WindowByObjectId slices the query into hs_object_id ranges and returns results in a deterministic order.
+	WindowByObjectId SearchWindowBy = iota
+	// WindowByLastModified slices the query into last modified date ranges.
+	WindowByLastModified
+)
+
+// SearchWindow describes the property used to slice a search into queries of less than MaxSearchResults results.
+// Value must return the property value of an item in a form accepted by a GTE filter, or an empty string
+// when the item has no value. Items without a value are returned but never used as a window bound.
+type SearchWindow[T any] struct {
+	Property string
+	Value    func(T) string
+	Id       func(T) string
+}
+
+// SearchFunc runs a single search request and returns the results together with the cursor of the next page.
+type SearchFunc[T any] func(ctx context.Context, options SearchOptions) ([]T, string, error)
+
+type searchAllState[T any] struct {
+	options   SearchOptions
+	window    SearchWindow[T]
+	search    SearchFunc[T]
+	lower     string
+	after     string
+	skip      map[string]bool
+	lastValue string
+	lastIds   map[string]bool
+	err       error
+}
+
+// SearchAll walks every result of options, even past MaxSearchResults. Results are sorted ascending by the window
+// property and, whenever the 10k limit is near, a new query is started with a GTE filter on the last value seen.
+// With WindowByObjectId the results come back in a stable order, which makes it suitable for exports.
+// The returned Pager's After cursor cannot be used to resume.
+//
+// options.Sorts must be empty or hold only an ascending sort on the window property, any other sort fails
+// with ErrSearchAllSort. The window filter is added to every filter group, so the groups must leave room
+// for one more filter within MaxFiltersPerGroup and MaxFilters. Both are checked before the first request.
+func SearchAll[T any](options SearchOptions, window SearchWindow[T], search SearchFunc[T]) *Pager[T] {
+	s := &searchAllState[T]{
+		options: options,
+		window:  window,
+		search:  search,
+	}
+	s.err = validateSearchAll(options, window.Property)
+	s.options.Sorts = []Sort{SortAscending(window.Property)}
+	if len(s.options.Properties) > 0 && !containsString(s.options.Properties, window.Property) {
+		s.options.Properties = append(append([]string{}, s.options.Properties...), window.Property)
+	}
+	return NewPager(s.next)
+}
+
+func validateSearchAll(options SearchOptions, property string) error {
+	for _, sort := range options.Sorts {
+		if sort.PropertyName != property || (sort.Direction != "" && sort.Direction != Ascending) {
+			return fmt.Errorf("%w: got %s %s, want %s ascending", ErrSearchAllSort, sort.PropertyName, sort.Direction, property)
+		}
+	}
+	// Validate the groups as they will be sent once the window has moved past its first query
+	return ValidateFilterGroups(withFilter(options.FilterGroups, Filters{
+		PropertyName: property,
+		Operator:     GreaterThanEqualTo,
+		Value:        "0",
+	}))
+}
+
+func (s *searchAllState[T]) next(ctx context.Context, _ string) ([]T, string, error) {
+	if s.err != nil {
+		return nil, "", s.err
+	}
+	options := s.options
+	options.After = s.after
+	if s.lower != "" {
+		options.FilterGroups = withFilter(options.FilterGroups, Filters{
+			PropertyName: s.window.Property,
+			Operator:     GreaterThanEqualTo,
+			Value:        s.lower,
+		})
+	}
+
+	items, after, err := s.search(ctx, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	page := make([]T, 0, len(items))
+	for _, item := range items {
+		id := s.window.Id(item)
+		if s.skip[id] {
+			continue
+		}
+		page = append(page, item)
+
+		value := s.window.Value(item)
+		if value == "" {
+			continue
+		}
+		if value != s.lastValue {
+			s.lastValue = value
+			s.lastIds = make(map[string]bool)
+		}
+		s.lastIds[id] = true
+	}
+
+	limit := int(options.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	s.after = after
+	if offset, err := strconv.Atoi(after); err == nil && offset+limit > MaxSearchResults {
+		if s.lastValue == "" || s.lastValue == s.lower {
+			return nil, "", ErrSearchWindowExhausted
+		}
+		s.lower = s.lastValue
+		s.skip = s.lastIds
+		s.after = ""
+		return page, s.lower + "/", nil
+	}
+	if s.after == "" {
+		return page, "", nil
+	}
+	return page, s.lower + "/" + s.after, nil
+}
+
+// withFilter returns a copy of groups with filter ANDed into every group.
+func withFilter(groups []FilterGroups, filter Filters) []FilterGroups {
+	if len(groups) == 0 {
+		return []FilterGroups{{Filters: []Filters{filter}}}
+	}
+	out := make([]FilterGroups, len(groups))
+	for i, group := range groups {
+		out[i] = FilterGroups{Filters: append(append([]Filters{}, group.Filters...), filter)}
+	}
+	return out
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchAllContacts walks every contact matching options, see SearchAll.
+func SearchAllContacts(client *Client, options *ContactSearchOptions, by SearchWindowBy) *Pager[Contact] {
+	window := SearchWindow[Contact]{
+		Property: ObjectIdProperty,
+		Value:    func(c Contact) string { return c.Id },
+		Id:       func(c Contact) string { return c.Id },
+	}
+	if by == WindowByLastModified {
+		window.Property = "lastmodifieddate"
+		window.Value = func(c Contact) string { return c.Properties.Lastmodifieddate.FilterValue() }
+	}
+	return SearchAll(options.SearchOptions, window, func(ctx context.Context, o SearchOptions) ([]Contact, string, error) {
+		res, err := client.Contacts.Search(ctx, &ContactSearchOptions{SearchOptions: o})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Results, res.NextAfter(), nil
+	})
+}
+
+// SearchAllCompanies walks every company matching options, see SearchAll.
+func SearchAllCompanies(client *Client, options *CompanySearchOptions, by SearchWindowBy) *Pager[Company] {
+	window := SearchWindow[Company]{
+		Property: ObjectIdProperty,
+		Value:    func(c Company) string { return c.Id },
+		Id:       func(c Company) string { return c.Id },
+	}
+	if by == WindowByLastModified {
+		window.Property = "hs_lastmodifieddate"
+		window.Value = func(c Company) string { return c.Properties.HsLastmodifieddate.FilterValue() }
+	}
+	return SearchAll(options.SearchOptions, window, func(ctx context.Context, o SearchOptions) ([]Company, string, error) {
+		res, err := client.Companies.Search(ctx, &CompanySearchOptions{SearchOptions: o})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Results, res.NextAfter(), nil
+	})
+}
+
+// SearchAllDeals walks every deal matching options, see SearchAll.
+func SearchAllDeals(client *Client, options *DealSearchOptions, by SearchWindowBy) *Pager[Deal] {
+	window := SearchWindow[Deal]{
+		Property: ObjectIdProperty,
+		Value:    func(d Deal) string { return d.Id },
+		Id:       func(d Deal) string { return d.Id },
+	}
+	if by == WindowByLastModified {
+		window.Property = "hs_lastmodifieddate"
+		window.Value = func(d Deal) string { return d.Properties.HsLastmodifieddate.FilterValue() }
+	}
+	return SearchAll(options.SearchOptions, window, func(ctx context.Context, o SearchOptions) ([]Deal, string, error) {
+		res, err := client.Deals.Search(ctx, &DealSearchOptions{SearchOptions: o})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Results, res.NextAfter(), nil
+	})
+}
+
+// SearchAllTickets walks every ticket matching options, see SearchAll.
+func SearchAllTickets(client *Client, options *TicketSearchOptions, by SearchWindowBy) *Pager[Ticket] {
+	window := SearchWindow[Ticket]{
+		Property: ObjectIdProperty,
+		Value:    func(t Ticket) string { return t.Id },
+		Id:       func(t Ticket) string { return t.Id },
+	}
+	if by == WindowByLastModified {
+		window.Property = "hs_lastmodifieddate"
+		window.Value = func(t Ticket) string { return t.Properties.HsLastmodifieddate.FilterValue() }
+	}
+	return SearchAll(options.SearchOptions, window, func(ctx context.Context, o SearchOptions) ([]Ticket, string, error) {
+		res, err := client.Tickets.Search(ctx, &TicketSearchOptions{SearchOptions: o})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Results, res.NextAfter(), nil
+	})
+}
@@ -0,0 +1,190 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeSearch serves /crm/v3/objects/contacts/search over contacts with ids 1 to n, supporting the
+// hs_object_id GTE filter and ascending sort SearchAll relies on. Like HubSpot it refuses to page
+// past MaxSearchResults.
+func fakeSearch(t *testing.T, n int, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		options := SearchOptions{}
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			t.Error(err)
+		}
+		if len(options.Sorts) != 1 || options.Sorts[0] != SortAscending(ObjectIdProperty) {
+			t.Errorf("sorts = %+v", options.Sorts)
+		}
+		lower := 1
+		for _, group := range options.FilterGroups {
+			for _, f := range group.Filters {
+				if f.PropertyName == ObjectIdProperty && f.Operator == GreaterThanEqualTo {
+					lower, _ = strconv.Atoi(f.Value)
+				}
+			}
+		}
+		offset, _ := strconv.Atoi(options.After)
+		limit := int(options.Limit)
+		if limit == 0 {
+			limit = 10
+		}
+		if offset+limit > MaxSearchResults {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","message":"paging past 10000 results"}`))
+			return
+		}
+
+		res := ContactSearchResults{Results: make([]Contact, 0, limit)}
+		for id := lower + offset; id <= n && len(res.Results) < limit; id++ {
+			res.Results = append(res.Results, Contact{Id: strconv.Itoa(id)})
+		}
+		if lower+offset+limit <= n {
+			res.Paging.Next.After = strconv.Itoa(offset + limit)
+		}
+		json.NewEncoder(w).Encode(res)
+	}
+}
+
+func TestSearchAllWindowsPastMaxResults(t *testing.T) {
+	const n = 25050
+	var requests int32
+	client := newTestClient(t, fakeSearch(t, n, &requests))
+
+	options := &ContactSearchOptions{SearchOptions: SearchOptions{Limit: 200}}
+	contacts, err := SearchAllContacts(client, options, WindowByObjectId).CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contacts) != n {
+		t.Fatalf("got %d contacts, want %d", len(contacts), n)
+	}
+	for i, contact := range contacts {
+		if contact.Id != strconv.Itoa(i+1) {
+			t.Fatalf("contact %d has id %s", i, contact.Id)
+		}
+	}
+	if requests < n/200 {
+		t.Fatalf("only %d requests", requests)
+	}
+}
+
+func TestSearchAllRejectsOtherSorts(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, fakeSearch(t, 10, &requests))
+
+	options := &ContactSearchOptions{SearchOptions: SearchOptions{Sorts: []Sort{SortDescending("createdate")}}}
+	_, err := SearchAllContacts(client, options, WindowByObjectId).CollectAll(context.Background())
+	if !errors.Is(err, ErrSearchAllSort) {
+		t.Fatalf("err = %v, want ErrSearchAllSort", err)
+	}
+
+	options.Sorts = []Sort{StableSort()}
+	if _, err = SearchAllContacts(client, options, WindowByObjectId).CollectAll(context.Background()); err != nil {
+		t.Fatalf("window sort rejected: %v", err)
+	}
+}
+
+func TestSearchAllValidatesFilterLimits(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, fakeSearch(t, 10, &requests))
+
+	group := FilterGroups{}
+	for i := 0; i < MaxFiltersPerGroup; i++ {
+		group.Filters = append(group.Filters, Filters{PropertyName: "p" + strconv.Itoa(i), Operator: HasProperty})
+	}
+	options := &ContactSearchOptions{SearchOptions: SearchOptions{FilterGroups: []FilterGroups{group}}}
+	_, err := SearchAllContacts(client, options, WindowByObjectId).CollectAll(context.Background())
+	if !errors.Is(err, ErrInvalidFilter) {
+		t.Fatalf("err = %v, want ErrInvalidFilter", err)
+	}
+	if requests != 0 {
+		t.Fatalf("%d requests sent for an invalid query", requests)
+	}
+}
+
+type windowItem struct {
+	id    int
+	value string
+}
+
+// windowSearch searches items sorted by value, skipping items without a value once a GTE filter is set.
+func windowSearch(items []windowItem) SearchFunc[windowItem] {
+	sorted := append([]windowItem{}, items...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].value < sorted[j].value })
+	return func(ctx context.Context, options SearchOptions) ([]windowItem, string, error) {
+		matching := sorted
+		for _, group := range options.FilterGroups {
+			for _, f := range group.Filters {
+				matching = nil
+				for _, item := range sorted {
+					if item.value != "" && item.value >= f.Value {
+						matching = append(matching, item)
+					}
+				}
+			}
+		}
+		offset, _ := strconv.Atoi(options.After)
+		end := min(offset+int(options.Limit), len(matching))
+		if offset >= MaxSearchResults {
+			return nil, "", errors.New("paging past 10000 results")
+		}
+		after := ""
+		if end < len(matching) {
+			after = strconv.Itoa(end)
+		}
+		return matching[offset:end], after, nil
+	}
+}
+
+var testWindow = SearchWindow[windowItem]{
+	Property: "lastmodifieddate",
+	Value:    func(i windowItem) string { return i.value },
+	Id:       func(i windowItem) string { return strconv.Itoa(i.id) },
+}
+
+func TestSearchAllSkipsMissingWindowValues(t *testing.T) {
+	items := make([]windowItem, 0, 12000)
+	for i := 0; i < 12000; i++ {
+		value := ""
+		if i >= 100 {
+			// three items share each value
+			value = strconv.Itoa(1000000 + i/3)
+		}
+		items = append(items, windowItem{id: i, value: value})
+	}
+
+	got, err := SearchAll(SearchOptions{Limit: 100}, testWindow, windowSearch(items)).CollectAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[int]bool)
+	for _, item := range got {
+		if seen[item.id] {
+			t.Fatalf("item %d returned twice", item.id)
+		}
+		seen[item.id] = true
+	}
+	if len(seen) != len(items) {
+		t.Fatalf("got %d items, want %d", len(seen), len(items))
+	}
+}
+
+func TestSearchAllWindowWithoutValuesIsExhausted(t *testing.T) {
+	items := make([]windowItem, MaxSearchResults+10)
+	for i := range items {
+		items[i] = windowItem{id: i}
+	}
+	_, err := SearchAll(SearchOptions{Limit: 100}, testWindow, windowSearch(items)).CollectAll(context.Background())
+	if !errors.Is(err, ErrSearchWindowExhausted) {
+		t.Fatalf("err = %v, want ErrSearchWindowExhausted", err)
+	}
+}
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Tasks interface {
 	BatchCreate(ctx context.Context, options *TaskBatchCreateOptions) (*TaskBatchOutput, error)
 	BatchRead(ctx context.Context, options *TaskBatchReadOptions) (*TaskBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *TaskBatchUpdateOptions) (*TaskBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *TaskBatchUpsertOptions) (*TaskBatchUpsertOutput, error)
 	Search(ctx context.Context, options *TaskSearchOptions) (*TaskSearchResults, error)
 	Merge(ctx context.Context, options *TaskMergeOptions) (*Task, error)
 }
@@ -50,11 +52,13 @@ type TaskList struct {
 }
 
 type Task struct {
-	Id         string         `json:"id"`
-	Properties TaskProperties `json:"properties"`
-	CreatedAt  string         `json:"createdAt"`
-	UpdatedAt  string         `json:"updatedAt"`
-	Archived   bool           `json:"archived"`
+	Id                    string             `json:"id"`
+	Properties            TaskProperties     `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
+	CreatedAt             string             `json:"createdAt"`
+	UpdatedAt             string             `json:"updatedAt"`
+	Archived              bool               `json:"archived"`
 }
 
 type TaskProperties struct {
@@ -66,6 +70,32 @@ type TaskProperties struct {
 	HsTaskSubject      string `json:"hs_task_subject"`
 	HsTimestamp        string `json:"hs_timestamp"`
 	HubSpotOwnerId     string `json:"hubspot_owner_id"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p TaskProperties) MarshalJSON() ([]byte, error) {
+	type properties TaskProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *TaskProperties) UnmarshalJSON(b []byte) error {
+	type properties TaskProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
+}
+
+// CreateOrUpdateProperties returns the writable properties of p, including its custom properties in Raw,
+// so that a read task can be modified and passed to Update without losing them.
+func (p TaskProperties) CreateOrUpdateProperties() TaskCreateOrUpdateProperties {
+	return TaskCreateOrUpdateProperties{
+		HsTaskBody:     p.HsTaskBody,
+		HsTaskPriority: p.HsTaskPriority,
+		HsTaskStatus:   p.HsTaskStatus,
+		HsTaskSubject:  p.HsTaskSubject,
+		HsTimestamp:    p.HsTimestamp,
+		HubSpotOwnerId: p.HubSpotOwnerId,
+		Raw:            writableRawProperties(p.Raw),
+	}
 }
 
 type TaskCreateOrUpdateOptions struct {
@@ -79,6 +109,18 @@ type TaskCreateOrUpdateProperties struct {
 	HsTaskSubject  string `json:"hs_task_subject"`
 	HsTimestamp    string `json:"hs_timestamp"`
 	HubSpotOwnerId string `json:"hubspot_owner_id"`
+
+	Raw RawProperties `json:"-"`
+}
+
+func (p TaskCreateOrUpdateProperties) MarshalJSON() ([]byte, error) {
+	type properties TaskCreateOrUpdateProperties
+	return MarshalPropertiesWithRaw(properties(p), p.Raw)
+}
+
+func (p *TaskCreateOrUpdateProperties) UnmarshalJSON(b []byte) error {
+	type properties TaskCreateOrUpdateProperties
+	return UnmarshalPropertiesWithRaw(b, (*properties)(p), &p.Raw)
 }
 
 type TaskReadQuery struct {
@@ -95,6 +137,7 @@ type TaskBatchOutput struct {
 	RequestedAt string `json:"requestedAt"`
 	StartedAt   string `json:"startedAt"`
 	CompletedAt string `json:"completedAt"`
+	BatchErrors
 }
 
 type TaskBatchReadOptions struct {
@@ -111,9 +154,38 @@ type TaskBatchUpdateOptions struct {
 
 type TaskBatchUpdateProperties struct {
 	Id         string                       `json:"id"`
+	IdProperty string                       `json:"idProperty,omitempty"`
 	Properties TaskCreateOrUpdateProperties `json:"properties"`
 }
 
+// TaskBatchUpsertOptions creates or updates the tasks whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type TaskBatchUpsertOptions struct {
+	IdProperty string                      `json:"-"`
+	Inputs     []TaskBatchUpdateProperties `json:"inputs"`
+}
+
+func (o TaskBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options TaskBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *TaskBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type TaskBatchUpsertOutput struct {
+	Status      string             `json:"status"`
+	Results     []TaskUpsertResult `json:"results"`
+	RequestedAt string             `json:"requestedAt"`
+	StartedAt   string             `json:"startedAt"`
+	CompletedAt string             `json:"completedAt"`
+	BatchErrors
+}
+
+// TaskUpsertResult is an upserted task. New is true when it was created and false when it was updated.
+type TaskUpsertResult struct {
+	Task
+	New bool `json:"new"`
+}
+
 type TaskSearchOptions struct {
 	SearchOptions
 }
@@ -260,63 +332,39 @@ func (z *tasks) BatchArchive(ctx context.Context, taskIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: taskId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *tasks) BatchCreate(ctx context.Context, options *TaskBatchCreateOptions) (*TaskBatchOutput, error) {
 	u := "/crm/v3/objects/tasks/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tasks := &TaskBatchOutput{}
 
-	err = z.client.do(req, tasks)
-	if err != nil {
-		return nil, err
-	}
-
-	return tasks, nil
+	err := z.client.doBatch(ctx, u, options, tasks)
+	return batchResult(tasks, err)
 }
 
 func (z *tasks) BatchRead(ctx context.Context, options *TaskBatchReadOptions) (*TaskBatchOutput, error) {
 	u := "/crm/v3/objects/tasks/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tasks := &TaskBatchOutput{}
 
-	err = z.client.do(req, tasks)
-	if err != nil {
-		return nil, err
-	}
-
-	return tasks, nil
+	err := z.client.doBatch(ctx, u, options, tasks)
+	return batchResult(tasks, err)
 }
 
 func (z *tasks) BatchUpdate(ctx context.Context, options *TaskBatchUpdateOptions) (*TaskBatchOutput, error) {
 	u := "/crm/v3/objects/tasks/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tasks := &TaskBatchOutput{}
 
-	err = z.client.do(req, tasks)
-	if err != nil {
-		return nil, err
-	}
+	err := z.client.doBatch(ctx, u, options, tasks)
+	return batchResult(tasks, err)
+}
 
-	return tasks, nil
+func (z *tasks) BatchUpsert(ctx context.Context, options *TaskBatchUpsertOptions) (*TaskBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/tasks/batch/upsert"
+	tasks := &TaskBatchUpsertOutput{}
+
+	err := z.client.doBatch(ctx, u, options, tasks)
+	return batchResult(tasks, err)
 }
 
 func (z *tasks) Search(ctx context.Context, options *TaskSearchOptions) (*TaskSearchResults, error) {
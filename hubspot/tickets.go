@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -18,6 +19,7 @@ type Tickets interface {
 	BatchCreate(ctx context.Context, options *TicketBatchCreateOptions) (*TicketBatchOutput, error)
 	BatchRead(ctx context.Context, options *TicketBatchReadOptions) (*TicketBatchOutput, error)
 	BatchUpdate(ctx context.Context, options *TicketBatchUpdateOptions) (*TicketBatchOutput, error)
+	BatchUpsert(ctx context.Context, options *TicketBatchUpsertOptions) (*TicketBatchUpsertOutput, error)
 	Search(ctx context.Context, options *TicketSearchOptions) (*TicketSearchResults, error)
 	Merge(ctx context.Context, options *MergeOptions) (*Ticket, error)
 }
@@ -50,8 +52,10 @@ type TicketList struct {
 }
 
 type Ticket struct {
-	Id         string           `json:"id"`
-	Properties TicketProperties `json:"properties"`
+	Id                    string             `json:"id"`
+	Properties            TicketProperties   `json:"properties"`
+	PropertiesWithHistory PropertyHistory    `json:"propertiesWithHistory,omitempty"`
+	Associations          ObjectAssociations `json:"associations,omitempty"`
 }
 
 type TicketCreateOrUpdateOptions struct {
@@ -68,6 +72,7 @@ type TicketBatchOutput struct {
 	RequestedAt string   `json:"requestedAt"`
 	StartedAt   string   `json:"startedAt"`
 	CompletedAt string   `json:"completedAt"`
+	BatchErrors
 }
 
 type TicketBatchReadOptions struct {
@@ -84,9 +89,38 @@ type TicketBatchUpdateOptions struct {
 
 type TicketBatchUpdateProperties struct {
 	Id         string           `json:"id"`
+	IdProperty string           `json:"idProperty,omitempty"`
 	Properties TicketProperties `json:"properties"`
 }
 
+// TicketBatchUpsertOptions creates or updates the tickets whose IdProperty value matches the Id of each input.
+// IdProperty applies to every input which does not set its own.
+type TicketBatchUpsertOptions struct {
+	IdProperty string                        `json:"-"`
+	Inputs     []TicketBatchUpdateProperties `json:"inputs"`
+}
+
+func (o TicketBatchUpsertOptions) MarshalJSON() ([]byte, error) {
+	type options TicketBatchUpsertOptions
+	o.Inputs = upsertInputs(o.Inputs, o.IdProperty, func(p *TicketBatchUpdateProperties) *string { return &p.IdProperty })
+	return json.Marshal(options(o))
+}
+
+type TicketBatchUpsertOutput struct {
+	Status      string               `json:"status"`
+	Results     []TicketUpsertResult `json:"results"`
+	RequestedAt string               `json:"requestedAt"`
+	StartedAt   string               `json:"startedAt"`
+	CompletedAt string               `json:"completedAt"`
+	BatchErrors
+}
+
+// TicketUpsertResult is an upserted ticket. New is true when it was created and false when it was updated.
+type TicketUpsertResult struct {
+	Ticket
+	New bool `json:"new"`
+}
+
 type TicketSearchOptions struct {
 	SearchOptions
 }
@@ -94,6 +128,7 @@ type TicketSearchOptions struct {
 type TicketSearchResults struct {
 	Total   int64    `json:"total"`
 	Results []Ticket `json:"results"`
+	Pagination
 }
 
 type TicketMergeOptions struct {
@@ -232,63 +267,39 @@ func (z *tickets) BatchArchive(ctx context.Context, ticketIds []string) error {
 		options.Inputs = append(options.Inputs, BatchInput{Id: ticketId})
 	}
 
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return err
-	}
-
-	return z.client.do(req, nil)
+	return z.client.doBatch(ctx, u, options, nil)
 }
 
 func (z *tickets) BatchCreate(ctx context.Context, options *TicketBatchCreateOptions) (*TicketBatchOutput, error) {
 	u := "/crm/v3/objects/tickets/batch/create"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tbr := &TicketBatchOutput{}
 
-	err = z.client.do(req, tbr)
-	if err != nil {
-		return nil, err
-	}
-
-	return tbr, nil
+	err := z.client.doBatch(ctx, u, options, tbr)
+	return batchResult(tbr, err)
 }
 
 func (z *tickets) BatchRead(ctx context.Context, options *TicketBatchReadOptions) (*TicketBatchOutput, error) {
 	u := "/crm/v3/objects/tickets/batch/read"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tbr := &TicketBatchOutput{}
 
-	err = z.client.do(req, tbr)
-	if err != nil {
-		return nil, err
-	}
-
-	return tbr, nil
+	err := z.client.doBatch(ctx, u, options, tbr)
+	return batchResult(tbr, err)
 }
 
 func (z *tickets) BatchUpdate(ctx context.Context, options *TicketBatchUpdateOptions) (*TicketBatchOutput, error) {
 	u := "/crm/v3/objects/tickets/batch/update"
-	req, err := z.client.newHttpRequest(ctx, "POST", u, options)
-	if err != nil {
-		return nil, err
-	}
-
 	tbr := &TicketBatchOutput{}
 
-	err = z.client.do(req, tbr)
-	if err != nil {
-		return nil, err
-	}
+	err := z.client.doBatch(ctx, u, options, tbr)
+	return batchResult(tbr, err)
+}
+
+func (z *tickets) BatchUpsert(ctx context.Context, options *TicketBatchUpsertOptions) (*TicketBatchUpsertOutput, error) {
+	u := "/crm/v3/objects/tickets/batch/upsert"
+	tbr := &TicketBatchUpsertOutput{}
 
-	return tbr, nil
+	err := z.client.doBatch(ctx, u, options, tbr)
+	return batchResult(tbr, err)
 }
 
 func (z *tickets) Search(ctx context.Context, options *TicketSearchOptions) (*TicketSearchResults, error) {
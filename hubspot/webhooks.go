@@ -0,0 +1,215 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+)
+
+// Webhooks manages the webhook settings and subscriptions of an app. Its calls authenticate with the
+// developer API key, see NewDeveloperClient and WithDeveloperAPIKey.
+type Webhooks interface {
+	ReadSettings(ctx context.Context, appId string) (*WebhookSettings, error)
+	UpdateSettings(ctx context.Context, appId string, options *WebhookSettingsUpdateOptions) (*WebhookSettings, error)
+	DeleteSettings(ctx context.Context, appId string) error
+	ListSubscriptions(ctx context.Context, appId string) (*WebhookSubscriptionList, error)
+	CreateSubscription(ctx context.Context, appId string, options *WebhookSubscriptionCreateOptions) (*WebhookSubscription, error)
+	ReadSubscription(ctx context.Context, appId string, subscriptionId string) (*WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, appId string, subscriptionId string, options *WebhookSubscriptionUpdateOptions) (*WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, appId string, subscriptionId string) error
+	BatchUpdateSubscriptions(ctx context.Context, appId string, options *WebhookSubscriptionBatchUpdateOptions) (*WebhookSubscriptionBatchOutput, error)
+}
+
+type webhooks struct {
+	client *Client
+}
+
+type WebhookSettings struct {
+	TargetUrl  string            `json:"targetUrl"`
+	Throttling WebhookThrottling `json:"throttling"`
+	CreatedAt  string            `json:"createdAt,omitempty"`
+	UpdatedAt  string            `json:"updatedAt,omitempty"`
+}
+
+// WebhookThrottling limits the number of concurrent requests HubSpot sends to the target URL.
+type WebhookThrottling struct {
+	MaxConcurrentRequests int    `json:"maxConcurrentRequests"`
+	Period                string `json:"period,omitempty"`
+}
+
+type WebhookSettingsUpdateOptions struct {
+	TargetUrl  string            `json:"targetUrl"`
+	Throttling WebhookThrottling `json:"throttling"`
+}
+
+type WebhookSubscriptionList struct {
+	Results []WebhookSubscription `json:"results"`
+}
+
+type WebhookSubscription struct {
+	Id           string `json:"id"`
+	EventType    string `json:"eventType"`
+	PropertyName string `json:"propertyName,omitempty"`
+	Active       bool   `json:"active"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+	UpdatedAt    string `json:"updatedAt,omitempty"`
+}
+
+// WebhookSubscriptionCreateOptions subscribes to an event type such as contact.propertyChange.
+// PropertyName is required for propertyChange event types.
+type WebhookSubscriptionCreateOptions struct {
+	EventType    string `json:"eventType"`
+	PropertyName string `json:"propertyName,omitempty"`
+	Active       bool   `json:"active"`
+}
+
+type WebhookSubscriptionUpdateOptions struct {
+	Active bool `json:"active"`
+}
+
+type WebhookSubscriptionBatchUpdateOptions struct {
+	Inputs []WebhookSubscriptionBatchInput `json:"inputs"`
+}
+
+type WebhookSubscriptionBatchInput struct {
+	Id     int64 `json:"id"`
+	Active bool  `json:"active"`
+}
+
+type WebhookSubscriptionBatchOutput struct {
+	Status      string                `json:"status"`
+	Results     []WebhookSubscription `json:"results"`
+	RequestedAt string                `json:"requestedAt"`
+	StartedAt   string                `json:"startedAt"`
+	CompletedAt string                `json:"completedAt"`
+	BatchErrors
+}
+
+func (z *webhooks) ReadSettings(ctx context.Context, appId string) (*WebhookSettings, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/settings", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &WebhookSettings{}
+
+	err = z.client.do(req, settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (z *webhooks) UpdateSettings(ctx context.Context, appId string, options *WebhookSettingsUpdateOptions) (*WebhookSettings, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/settings", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "PUT", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &WebhookSettings{}
+
+	err = z.client.do(req, settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (z *webhooks) DeleteSettings(ctx context.Context, appId string) error {
+	u := fmt.Sprintf("/webhooks/v3/%s/settings", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
+
+func (z *webhooks) ListSubscriptions(ctx context.Context, appId string) (*WebhookSubscriptionList, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := &WebhookSubscriptionList{}
+
+	err = z.client.do(req, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (z *webhooks) CreateSubscription(ctx context.Context, appId string, options *WebhookSubscriptionCreateOptions) (*WebhookSubscription, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &WebhookSubscription{}
+
+	err = z.client.do(req, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (z *webhooks) ReadSubscription(ctx context.Context, appId string, subscriptionId string) (*WebhookSubscription, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions/%s", appId, subscriptionId)
+	req, err := z.client.newDeveloperRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &WebhookSubscription{}
+
+	err = z.client.do(req, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (z *webhooks) UpdateSubscription(ctx context.Context, appId string, subscriptionId string, options *WebhookSubscriptionUpdateOptions) (*WebhookSubscription, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions/%s", appId, subscriptionId)
+	req, err := z.client.newDeveloperRequest(ctx, "PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &WebhookSubscription{}
+
+	err = z.client.do(req, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (z *webhooks) DeleteSubscription(ctx context.Context, appId string, subscriptionId string) error {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions/%s", appId, subscriptionId)
+	req, err := z.client.newDeveloperRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	return z.client.do(req, nil)
+}
+
+func (z *webhooks) BatchUpdateSubscriptions(ctx context.Context, appId string, options *WebhookSubscriptionBatchUpdateOptions) (*WebhookSubscriptionBatchOutput, error) {
+	u := fmt.Sprintf("/webhooks/v3/%s/subscriptions/batch/update", appId)
+	req, err := z.client.newDeveloperRequest(ctx, "POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := &WebhookSubscriptionBatchOutput{}
+
+	err = z.client.do(req, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
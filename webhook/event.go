@@ -0,0 +1,264 @@
+// Package webhook receives the events HubSpot delivers to the webhook URL of an app.
+//
+//	handler := webhook.NewHandler(clientSecret)
+//	handler.On("contact.creation", func(ctx context.Context, e webhook.Event) error {
+//		...
+//	})
+//	handler.On("*.propertyChange", func(ctx context.Context, e webhook.Event) error {
+//		change, _ := e.PropertyChange()
+//		...
+//	})
+//	http.Handle("/hubspot/webhook", handler)
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Action is the part of a subscription type after the object type, e.g. propertyChange.
+type Action string
+
+const (
+	ActionCreation          Action = "creation"
+	ActionDeletion          Action = "deletion"
+	ActionPropertyChange    Action = "propertyChange"
+	ActionAssociationChange Action = "associationChange"
+	ActionMerge             Action = "merge"
+	ActionRestore           Action = "restore"
+	ActionPrivacyDeletion   Action = "privacyDeletion"
+)
+
+// ObjectTypeObject is the object type of the subscription types of custom objects, e.g. object.creation.
+// The object type of the event is given by ObjectTypeId.
+const ObjectTypeObject = "object"
+
+// Event is a single event of a webhook delivery. Which fields are set depends on the Action of the event;
+// Payload and the typed accessors such as PropertyChange return only the fields of that action.
+type Event struct {
+	EventId          int64  `json:"eventId"`
+	SubscriptionId   int64  `json:"subscriptionId"`
+	SubscriptionType string `json:"subscriptionType"`
+	PortalId         int64  `json:"portalId"`
+	AppId            int64  `json:"appId"`
+	OccurredAt       int64  `json:"occurredAt"`
+	AttemptNumber    int    `json:"attemptNumber"`
+	ObjectId         int64  `json:"objectId,omitempty"`
+	ObjectTypeId     string `json:"objectTypeId,omitempty"`
+	ChangeSource     string `json:"changeSource,omitempty"`
+	ChangeFlag       string `json:"changeFlag,omitempty"`
+	SourceId         string `json:"sourceId,omitempty"`
+
+	// Set for propertyChange events
+	PropertyName  string `json:"propertyName,omitempty"`
+	PropertyValue string `json:"propertyValue,omitempty"`
+
+	// Set for associationChange events
+	AssociationType      string `json:"associationType,omitempty"`
+	AssociationTypeId    int64  `json:"associationTypeId,omitempty"`
+	AssociationCategory  string `json:"associationCategory,omitempty"`
+	FromObjectId         int64  `json:"fromObjectId,omitempty"`
+	FromObjectTypeId     string `json:"fromObjectTypeId,omitempty"`
+	ToObjectId           int64  `json:"toObjectId,omitempty"`
+	ToObjectTypeId       string `json:"toObjectTypeId,omitempty"`
+	AssociationRemoved   bool   `json:"associationRemoved,omitempty"`
+	IsPrimaryAssociation bool   `json:"isPrimaryAssociation,omitempty"`
+
+	// Set for merge events
+	PrimaryObjectId         int64   `json:"primaryObjectId,omitempty"`
+	MergedObjectIds         []int64 `json:"mergedObjectIds,omitempty"`
+	NewObjectId             int64   `json:"newObjectId,omitempty"`
+	NumberOfPropertiesMoved int     `json:"numberOfPropertiesMoved,omitempty"`
+}
+
+// ObjectType returns the part of the subscription type before the action, e.g. contact or object.
+func (e Event) ObjectType() string {
+	objectType, _, _ := strings.Cut(e.SubscriptionType, ".")
+	return objectType
+}
+
+// Action returns the part of the subscription type after the object type.
+func (e Event) Action() Action {
+	_, action, _ := strings.Cut(e.SubscriptionType, ".")
+	return Action(action)
+}
+
+// Time returns when the event occurred.
+func (e Event) Time() time.Time {
+	return time.UnixMilli(e.OccurredAt)
+}
+
+// Object identifies the CRM object an event is about. Type is the object type of the subscription,
+// e.g. contact or object, and TypeId is only sent for some subscriptions, e.g. those of custom objects.
+type Object struct {
+	Type   string
+	TypeId string
+	Id     int64
+}
+
+// CreationEvent is the payload of a creation event.
+type CreationEvent struct {
+	Object Object
+}
+
+// DeletionEvent is the payload of a deletion or privacyDeletion event.
+type DeletionEvent struct {
+	Object Object
+	// Privacy is set for privacyDeletion events, sent when a contact is deleted for privacy compliance.
+	Privacy bool
+}
+
+// RestoreEvent is the payload of a restore event.
+type RestoreEvent struct {
+	Object Object
+}
+
+// PropertyChangeEvent is the payload of a propertyChange event.
+type PropertyChangeEvent struct {
+	Object        Object
+	PropertyName  string
+	PropertyValue string
+	ChangeSource  string
+	SourceId      string
+}
+
+// AssociationChangeEvent is the payload of an associationChange event. Removed is set when the
+// association was deleted rather than created.
+type AssociationChangeEvent struct {
+	AssociationType     string
+	AssociationTypeId   int64
+	AssociationCategory string
+	From                Object
+	To                  Object
+	Removed             bool
+	Primary             bool
+}
+
+// MergeEvent is the payload of a merge event. Object is the record which remains after the merge,
+// whose id is NewObjectId when HubSpot created a new record.
+type MergeEvent struct {
+	Object                  Object
+	PrimaryObjectId         int64
+	MergedObjectIds         []int64
+	NewObjectId             int64
+	NumberOfPropertiesMoved int
+}
+
+func (e Event) object(id int64) Object {
+	return Object{Type: e.ObjectType(), TypeId: e.ObjectTypeId, Id: id}
+}
+
+// Creation returns the payload of a creation event, and false for any other action.
+func (e Event) Creation() (CreationEvent, bool) {
+	if e.Action() != ActionCreation {
+		return CreationEvent{}, false
+	}
+	return CreationEvent{Object: e.object(e.ObjectId)}, true
+}
+
+// Deletion returns the payload of a deletion or privacyDeletion event, and false for any other action.
+func (e Event) Deletion() (DeletionEvent, bool) {
+	action := e.Action()
+	if action != ActionDeletion && action != ActionPrivacyDeletion {
+		return DeletionEvent{}, false
+	}
+	return DeletionEvent{Object: e.object(e.ObjectId), Privacy: action == ActionPrivacyDeletion}, true
+}
+
+// Restore returns the payload of a restore event, and false for any other action.
+func (e Event) Restore() (RestoreEvent, bool) {
+	if e.Action() != ActionRestore {
+		return RestoreEvent{}, false
+	}
+	return RestoreEvent{Object: e.object(e.ObjectId)}, true
+}
+
+// PropertyChange returns the payload of a propertyChange event, and false for any other action.
+func (e Event) PropertyChange() (PropertyChangeEvent, bool) {
+	if e.Action() != ActionPropertyChange {
+		return PropertyChangeEvent{}, false
+	}
+	return PropertyChangeEvent{
+		Object:        e.object(e.ObjectId),
+		PropertyName:  e.PropertyName,
+		PropertyValue: e.PropertyValue,
+		ChangeSource:  e.ChangeSource,
+		SourceId:      e.SourceId,
+	}, true
+}
+
+// AssociationChange returns the payload of an associationChange event, and false for any other action.
+func (e Event) AssociationChange() (AssociationChangeEvent, bool) {
+	if e.Action() != ActionAssociationChange {
+		return AssociationChangeEvent{}, false
+	}
+	return AssociationChangeEvent{
+		AssociationType:     e.AssociationType,
+		AssociationTypeId:   e.AssociationTypeId,
+		AssociationCategory: e.AssociationCategory,
+		From:                Object{Type: e.ObjectType(), TypeId: e.FromObjectTypeId, Id: e.FromObjectId},
+		To:                  Object{TypeId: e.ToObjectTypeId, Id: e.ToObjectId},
+		Removed:             e.AssociationRemoved,
+		Primary:             e.IsPrimaryAssociation,
+	}, true
+}
+
+// Merge returns the payload of a merge event, and false for any other action.
+func (e Event) Merge() (MergeEvent, bool) {
+	if e.Action() != ActionMerge {
+		return MergeEvent{}, false
+	}
+	id := e.PrimaryObjectId
+	if e.NewObjectId != 0 {
+		id = e.NewObjectId
+	}
+	return MergeEvent{
+		Object:                  e.object(id),
+		PrimaryObjectId:         e.PrimaryObjectId,
+		MergedObjectIds:         e.MergedObjectIds,
+		NewObjectId:             e.NewObjectId,
+		NumberOfPropertiesMoved: e.NumberOfPropertiesMoved,
+	}, true
+}
+
+// Payload returns the typed payload of the event for a type switch: a CreationEvent, DeletionEvent,
+// RestoreEvent, PropertyChangeEvent, AssociationChangeEvent or MergeEvent. It returns nil for actions
+// without a typed payload.
+//
+//	switch p := event.Payload().(type) {
+//	case webhook.PropertyChangeEvent:
+//		...
+//	case webhook.MergeEvent:
+//		...
+//	}
+func (e Event) Payload() interface{} {
+	if p, ok := e.Creation(); ok {
+		return p
+	}
+	if p, ok := e.Deletion(); ok {
+		return p
+	}
+	if p, ok := e.Restore(); ok {
+		return p
+	}
+	if p, ok := e.PropertyChange(); ok {
+		return p
+	}
+	if p, ok := e.AssociationChange(); ok {
+		return p
+	}
+	if p, ok := e.Merge(); ok {
+		return p
+	}
+	return nil
+}
+
+// Parse decodes the JSON array of events of a webhook delivery.
+func Parse(body []byte) ([]Event, error) {
+	var events []Event
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
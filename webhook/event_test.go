@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readFixture(t *testing.T) []byte {
+	t.Helper()
+	body, err := os.ReadFile("testdata/events.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func parseFixture(t *testing.T) []Event {
+	t.Helper()
+	events, err := Parse(readFixture(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 7 {
+		t.Fatalf("parsed %d events, want 7", len(events))
+	}
+	return events
+}
+
+func TestParse(t *testing.T) {
+	events := parseFixture(t)
+	e := events[0]
+	if e.EventId != 1001 || e.SubscriptionId != 11 || e.PortalId != 62515 || e.ObjectId != 123 {
+		t.Fatalf("event = %+v", e)
+	}
+	if e.ObjectType() != "contact" || e.Action() != ActionCreation {
+		t.Fatalf("object type %q, action %q", e.ObjectType(), e.Action())
+	}
+	if !e.Time().Equal(time.UnixMilli(1704164645006)) {
+		t.Fatalf("time = %s", e.Time())
+	}
+	if _, err := Parse([]byte(`{"not":"an array"}`)); err == nil {
+		t.Fatal("expected an error for a payload which is not an array")
+	}
+}
+
+func TestTypedPayloads(t *testing.T) {
+	events := parseFixture(t)
+	want := []interface{}{
+		CreationEvent{Object: Object{Type: "contact", Id: 123}},
+		PropertyChangeEvent{
+			Object:        Object{Type: "contact", Id: 123},
+			PropertyName:  "email",
+			PropertyValue: "jane@example.com",
+			ChangeSource:  "INTEGRATION",
+			SourceId:      "1160452",
+		},
+		AssociationChangeEvent{
+			AssociationType:     "CONTACT_TO_COMPANY",
+			AssociationTypeId:   1,
+			AssociationCategory: "HUBSPOT_DEFINED",
+			From:                Object{Type: "contact", Id: 123},
+			To:                  Object{Id: 456},
+			Removed:             true,
+			Primary:             true,
+		},
+		MergeEvent{
+			Object:                  Object{Type: "company", Id: 459},
+			PrimaryObjectId:         456,
+			MergedObjectIds:         []int64{457, 458},
+			NewObjectId:             459,
+			NumberOfPropertiesMoved: 12,
+		},
+		RestoreEvent{Object: Object{Type: "deal", Id: 789}},
+		CreationEvent{Object: Object{Type: ObjectTypeObject, TypeId: "2-1234567", Id: 1001}},
+		DeletionEvent{Object: Object{Type: "contact", Id: 124}, Privacy: true},
+	}
+	for i, e := range events {
+		if got := e.Payload(); !reflect.DeepEqual(got, want[i]) {
+			t.Fatalf("event %d payload = %#v, want %#v", i, got, want[i])
+		}
+	}
+}
+
+func TestTypedAccessorsRejectOtherActions(t *testing.T) {
+	events := parseFixture(t)
+	if _, ok := events[0].PropertyChange(); ok {
+		t.Fatal("PropertyChange accepted a creation event")
+	}
+	if _, ok := events[1].Merge(); ok {
+		t.Fatal("Merge accepted a propertyChange event")
+	}
+	if _, ok := events[3].AssociationChange(); ok {
+		t.Fatal("AssociationChange accepted a merge event")
+	}
+	if _, ok := events[6].Creation(); ok {
+		t.Fatal("Creation accepted a privacyDeletion event")
+	}
+	if p := (Event{SubscriptionType: "contact.unknown"}).Payload(); p != nil {
+		t.Fatalf("unknown action payload = %#v", p)
+	}
+}
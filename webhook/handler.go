@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultMaxBodySize limits the size of a webhook delivery, which holds at most 100 events.
+const DefaultMaxBodySize = 1 << 20
+
+// EventFunc handles a single event. Returning an error fails the delivery so HubSpot retries it.
+type EventFunc func(ctx context.Context, event Event) error
+
+// Handler is an http.Handler which validates webhook deliveries and dispatches their events.
+type Handler struct {
+	// ClientSecret is the client secret of the app, used to validate signatures.
+	ClientSecret string
+	// BaseURL is the scheme and host HubSpot sends requests to, e.g. https://example.com. It is needed to
+	// validate v2 and v3 signatures behind a proxy; by default it is derived from the request.
+	BaseURL string
+	// MaxAge is how old a v3 signed request may be, DefaultMaxAge when zero.
+	MaxAge time.Duration
+	// MaxBodySize limits the size of a delivery, DefaultMaxBodySize when zero.
+	MaxBodySize int64
+	// OnError is called when a delivery is rejected or an EventFunc failed.
+	OnError func(r *http.Request, err error)
+
+	byType         map[string][]EventFunc
+	bySubscription map[int64][]EventFunc
+}
+
+// NewHandler creates a Handler validating deliveries with clientSecret.
+func NewHandler(clientSecret string) *Handler {
+	return &Handler{ClientSecret: clientSecret}
+}
+
+// On registers fn for a subscription type such as contact.creation. The object type or action may be
+// a wildcard, e.g. *.merge, object.* or *.
+func (h *Handler) On(subscriptionType string, fn EventFunc) {
+	if h.byType == nil {
+		h.byType = make(map[string][]EventFunc)
+	}
+	h.byType[subscriptionType] = append(h.byType[subscriptionType], fn)
+}
+
+// OnSubscription registers fn for the events of a single subscription of the app.
+func (h *Handler) OnSubscription(subscriptionId int64, fn EventFunc) {
+	if h.bySubscription == nil {
+		h.bySubscription = make(map[int64][]EventFunc)
+	}
+	h.bySubscription[subscriptionId] = append(h.bySubscription[subscriptionId], fn)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		h.fail(w, r, status, err)
+		return
+	}
+
+	maxAge := h.MaxAge
+	if maxAge == 0 {
+		maxAge = DefaultMaxAge
+	}
+	if err := Validate(r, h.requestURI(r), body, h.ClientSecret, maxAge); err != nil {
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	events, err := Parse(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err := h.Dispatch(r.Context(), events); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Dispatch calls the EventFuncs registered for each event, e.g. for events parsed from a fixture.
+// Every event is dispatched; the errors of failed EventFuncs are joined.
+func (h *Handler) Dispatch(ctx context.Context, events []Event) error {
+	var errs []error
+	for _, event := range events {
+		for _, fn := range h.handlersFor(event) {
+			if err := fn(ctx, event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *Handler) handlersFor(event Event) []EventFunc {
+	objectType, action := event.ObjectType(), string(event.Action())
+	var fns []EventFunc
+	for _, pattern := range []string{
+		event.SubscriptionType,
+		objectType + ".*",
+		"*." + action,
+		"*",
+	} {
+		fns = append(fns, h.byType[pattern]...)
+	}
+	return append(fns, h.bySubscription[event.SubscriptionId]...)
+}
+
+// requestURI returns the full URL of the request as HubSpot signed it.
+func (h *Handler) requestURI(r *http.Request) string {
+	base := strings.TrimSuffix(h.BaseURL, "/")
+	if base == "" {
+		scheme := "https"
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		} else if r.TLS == nil {
+			scheme = "http"
+		}
+		base = scheme + "://" + r.Host
+	}
+	return base + r.URL.RequestURI()
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	w.WriteHeader(status)
+}
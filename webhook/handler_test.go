@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func serve(h *Handler, r *http.Request, body []byte) int {
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+// recorder registers EventFuncs which record the ids of the events they receive.
+type recorder map[string][]int64
+
+func (rec recorder) fn(name string) EventFunc {
+	return func(ctx context.Context, e Event) error {
+		rec[name] = append(rec[name], e.EventId)
+		return nil
+	}
+}
+
+func TestHandlerDispatchesFixture(t *testing.T) {
+	body := readFixture(t)
+	rec := recorder{}
+	h := NewHandler(testSecret)
+	h.On("contact.propertyChange", rec.fn("contact.propertyChange"))
+	h.On("*.merge", rec.fn("*.merge"))
+	h.On("object.*", rec.fn("object.*"))
+	h.On("contact.*", rec.fn("contact.*"))
+	h.On("*", rec.fn("*"))
+	h.OnSubscription(15, rec.fn("subscription 15"))
+
+	if code := serve(h, signedRequest(t, "v3", body, time.Now()), body); code != http.StatusNoContent {
+		t.Fatalf("status = %d", code)
+	}
+
+	want := map[string][]int64{
+		"contact.propertyChange": {1002},
+		"*.merge":                {1004},
+		"object.*":               {1006},
+		"contact.*":              {1001, 1002, 1003, 1007},
+		"*":                      {1001, 1002, 1003, 1004, 1005, 1006, 1007},
+		"subscription 15":        {1005},
+	}
+	for name, ids := range want {
+		got := rec[name]
+		sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+		if len(got) != len(ids) {
+			t.Fatalf("%s received %v, want %v", name, got, ids)
+		}
+		for i := range ids {
+			if got[i] != ids[i] {
+				t.Fatalf("%s received %v, want %v", name, got, ids)
+			}
+		}
+	}
+}
+
+func TestHandlerAcceptsEverySignatureVersion(t *testing.T) {
+	body := readFixture(t)
+	for _, version := range []string{"v1", "v2", "v3"} {
+		h := NewHandler(testSecret)
+		if code := serve(h, signedRequest(t, version, body, time.Now()), body); code != http.StatusNoContent {
+			t.Fatalf("%s: status = %d", version, code)
+		}
+	}
+}
+
+func TestHandlerBaseURL(t *testing.T) {
+	body := readFixture(t)
+	// The proxy forwards to an internal address, the signature covers the public URL
+	r := signedRequest(t, "v3", body, time.Now())
+	r.Host = "internal:8080"
+	h := NewHandler(testSecret)
+	if code := serve(h, r, body); code != http.StatusUnauthorized {
+		t.Fatalf("status without BaseURL = %d, want 401", code)
+	}
+	h.BaseURL = "https://example.com/"
+	if code := serve(h, r, body); code != http.StatusNoContent {
+		t.Fatalf("status with BaseURL = %d", code)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestHandlerRejects(t *testing.T) {
+	body := readFixture(t)
+	var rejected []error
+	h := NewHandler(testSecret)
+	h.OnError = func(r *http.Request, err error) { rejected = append(rejected, err) }
+
+	if code := serve(h, httptest.NewRequest(http.MethodGet, testURI, nil), nil); code != http.StatusMethodNotAllowed {
+		t.Fatalf("GET status = %d", code)
+	}
+
+	tampered := bytes.Replace(body, []byte("jane"), []byte("john"), 1)
+	if code := serve(h, signedRequest(t, "v3", body, time.Now()), tampered); code != http.StatusUnauthorized {
+		t.Fatalf("tampered status = %d", code)
+	}
+	if code := serve(h, signedRequest(t, "v3", body, time.Now().Add(-time.Hour)), body); code != http.StatusUnauthorized {
+		t.Fatalf("expired status = %d", code)
+	}
+	if !errors.Is(rejected[len(rejected)-1], ErrRequestExpired) {
+		t.Fatalf("OnError got %v, want ErrRequestExpired", rejected[len(rejected)-1])
+	}
+
+	h.MaxBodySize = 16
+	if code := serve(h, signedRequest(t, "v3", body, time.Now()), body); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversized status = %d", code)
+	}
+	h.MaxBodySize = 0
+
+	r := signedRequest(t, "v3", body, time.Now())
+	r.Body = io.NopCloser(failingReader{})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("failed read status = %d, want 400", w.Code)
+	}
+
+	invalid := []byte(`{"eventId":1}`)
+	if code := serve(h, signedRequest(t, "v3", invalid, time.Now()), invalid); code != http.StatusBadRequest {
+		t.Fatalf("invalid payload status = %d", code)
+	}
+}
+
+func TestHandlerFailsDeliveryWhenEventFuncFails(t *testing.T) {
+	body := readFixture(t)
+	h := NewHandler(testSecret)
+	var calls int
+	h.On("*", func(ctx context.Context, e Event) error {
+		calls++
+		if e.EventId == 1002 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+	var dispatchErr error
+	h.OnError = func(r *http.Request, err error) { dispatchErr = err }
+
+	if code := serve(h, signedRequest(t, "v3", body, time.Now()), body); code != http.StatusInternalServerError {
+		t.Fatalf("status = %d", code)
+	}
+	if calls != 7 {
+		t.Fatalf("%d events dispatched, want every event despite the failure", calls)
+	}
+	if dispatchErr == nil || !strings.Contains(dispatchErr.Error(), "database unavailable") {
+		t.Fatalf("OnError got %v", dispatchErr)
+	}
+}
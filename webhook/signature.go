@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderSignature        = "X-HubSpot-Signature"
+	HeaderSignatureVersion = "X-HubSpot-Signature-Version"
+	HeaderSignatureV3      = "X-HubSpot-Signature-v3"
+	HeaderRequestTimestamp = "X-HubSpot-Request-Timestamp"
+
+	// DefaultMaxAge is how old a v3 signed request may be before it is rejected as a replay.
+	DefaultMaxAge = 5 * time.Minute
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrRequestExpired   = errors.New("webhook: request timestamp is too old")
+	ErrRequestInFuture  = errors.New("webhook: request timestamp is in the future")
+	ErrInvalidTimestamp = errors.New("webhook: missing or invalid request timestamp")
+)
+
+// SignatureV1 computes the v1 signature: the hex encoded SHA-256 of the client secret followed by the body.
+func SignatureV1(clientSecret string, body []byte) string {
+	sum := sha256.Sum256(append([]byte(clientSecret), body...))
+	return hex.EncodeToString(sum[:])
+}
+
+// SignatureV2 computes the v2 signature, which also covers the method and the full URI of the request.
+func SignatureV2(clientSecret string, method string, uri string, body []byte) string {
+	sum := sha256.Sum256([]byte(clientSecret + method + uri + string(body)))
+	return hex.EncodeToString(sum[:])
+}
+
+// SignatureV3 computes the v3 signature: the base64 encoded HMAC SHA-256 of the method, URI, body and
+// timestamp keyed with the client secret.
+func SignatureV3(clientSecret string, method string, uri string, body []byte, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(method + decodeV3URI(uri) + string(body) + timestamp))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// v3DecodedChars are the characters HubSpot decodes before signing a v3 request.
+const v3DecodedChars = ":/?@!$'()*,;"
+
+// decodeV3URI decodes the escapes of v3DecodedChars in uri. Escapes are matched case-insensitively,
+// so %3a and %3A both decode to ':'; every other escape is left as is.
+func decodeV3URI(uri string) string {
+	var b strings.Builder
+	b.Grow(len(uri))
+	for i := 0; i < len(uri); i++ {
+		if uri[i] == '%' && i+2 < len(uri) {
+			if c, err := strconv.ParseUint(uri[i+1:i+3], 16, 8); err == nil && strings.IndexByte(v3DecodedChars, byte(c)) >= 0 {
+				b.WriteByte(byte(c))
+				i += 2
+				continue
+			}
+		}
+		b.WriteByte(uri[i])
+	}
+	return b.String()
+}
+
+// Validate checks the signature of a request whose body has already been read. uri is the full URL
+// HubSpot sent the request to. The v3 signature is used when present, otherwise the version given by
+// X-HubSpot-Signature-Version. A v3 request whose timestamp is more than maxAge in the past or in
+// the future is rejected, the latter allowing for clock skew between HubSpot and the server.
+func Validate(r *http.Request, uri string, body []byte, clientSecret string, maxAge time.Duration) error {
+	if signature := r.Header.Get(HeaderSignatureV3); signature != "" {
+		timestamp := r.Header.Get(HeaderRequestTimestamp)
+		ms, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrInvalidTimestamp
+		}
+		age := time.Since(time.UnixMilli(ms))
+		if age > maxAge {
+			return ErrRequestExpired
+		}
+		if age < -maxAge {
+			return ErrRequestInFuture
+		}
+		return compare(signature, SignatureV3(clientSecret, r.Method, uri, body, timestamp))
+	}
+
+	signature := r.Header.Get(HeaderSignature)
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	switch r.Header.Get(HeaderSignatureVersion) {
+	case "v2":
+		return compare(strings.ToLower(signature), SignatureV2(clientSecret, r.Method, uri, body))
+	case "v1", "":
+		return compare(strings.ToLower(signature), SignatureV1(clientSecret, body))
+	}
+	return ErrInvalidSignature
+}
+
+func compare(got string, want string) error {
+	if !hmac.Equal([]byte(got), []byte(want)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+	testURI    = "https://example.com/hubspot/webhook?tenant=a%40b"
+)
+
+func timestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// signedRequest returns a POST of body to testURI signed with the given signature version.
+func signedRequest(t *testing.T, version string, body []byte, at time.Time) *http.Request {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, testURI, nil)
+	switch version {
+	case "v1":
+		r.Header.Set(HeaderSignatureVersion, "v1")
+		r.Header.Set(HeaderSignature, SignatureV1(testSecret, body))
+	case "v2":
+		r.Header.Set(HeaderSignatureVersion, "v2")
+		r.Header.Set(HeaderSignature, SignatureV2(testSecret, http.MethodPost, testURI, body))
+	case "v3":
+		ts := timestamp(at)
+		r.Header.Set(HeaderRequestTimestamp, ts)
+		r.Header.Set(HeaderSignatureV3, SignatureV3(testSecret, http.MethodPost, testURI, body, ts))
+	}
+	return r
+}
+
+func TestSignatureV1KnownValue(t *testing.T) {
+	// Example from HubSpot's documentation of v1 signatures
+	got := SignatureV1(testSecret, []byte(`[{"eventId":1,"subscriptionId":12345,"portalId":62515,"occurredAt":1564113600000,"subscriptionType":"contact.creation","attemptNumber":0,"objectId":123,"changeSource":"CRM","changeFlag":"NEW","appId":54321}]`))
+	if got != "232db2615f3d666fe21a8ec971ac7b5402d33b9a925784df3ca654d05f4817de" {
+		t.Fatalf("SignatureV1 = %s", got)
+	}
+}
+
+func TestSignatureV3DecodesURI(t *testing.T) {
+	body := []byte(`[]`)
+	if SignatureV3(testSecret, "POST", "https://example.com/a?b=c%40d", body, "1") != SignatureV3(testSecret, "POST", "https://example.com/a?b=c@d", body, "1") {
+		t.Fatal("v3 signature does not decode %40")
+	}
+}
+
+func TestDecodeV3URI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"https://example.com/a?b=c%3Ad", "https://example.com/a?b=c:d"},
+		{"https://example.com/a?b=c%3ad", "https://example.com/a?b=c:d"},
+		{"https://example.com/a?b=%2f%2F%2a%2c", "https://example.com/a?b=//*,"},
+		{"https://example.com/a?b=c%20d%2Be", "https://example.com/a?b=c%20d%2Be"},
+		{"https://example.com/a?b=%zz%4", "https://example.com/a?b=%zz%4"},
+	}
+	for _, tt := range tests {
+		if got := decodeV3URI(tt.uri); got != tt.want {
+			t.Errorf("decodeV3URI(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestValidateV3LowerCaseEscape(t *testing.T) {
+	body := readFixture(t)
+	ts := timestamp(time.Now())
+	r := httptest.NewRequest(http.MethodPost, "https://example.com/hubspot/webhook?tenant=a%3ab", nil)
+	r.Header.Set(HeaderRequestTimestamp, ts)
+	// HubSpot signs the decoded URI.
+	r.Header.Set(HeaderSignatureV3, SignatureV3(testSecret, http.MethodPost, "https://example.com/hubspot/webhook?tenant=a:b", body, ts))
+
+	if err := Validate(r, "https://example.com/hubspot/webhook?tenant=a%3ab", body, testSecret, DefaultMaxAge); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	body := readFixture(t)
+	now := time.Now()
+	for _, version := range []string{"v1", "v2", "v3"} {
+		if err := Validate(signedRequest(t, version, body, now), testURI, body, testSecret, DefaultMaxAge); err != nil {
+			t.Fatalf("%s: %v", version, err)
+		}
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	body := readFixture(t)
+	now := time.Now()
+	tests := []struct {
+		name string
+		r    *http.Request
+		body []byte
+		want error
+	}{
+		{"v1 tampered body", signedRequest(t, "v1", body, now), append([]byte(" "), body...), ErrInvalidSignature},
+		{"v2 other secret", func() *http.Request {
+			r := signedRequest(t, "v2", body, now)
+			r.Header.Set(HeaderSignature, SignatureV2("other", http.MethodPost, testURI, body))
+			return r
+		}(), body, ErrInvalidSignature},
+		{"v3 bad signature", func() *http.Request {
+			r := signedRequest(t, "v3", body, now)
+			r.Header.Set(HeaderSignatureV3, "bm90IGEgc2lnbmF0dXJl")
+			return r
+		}(), body, ErrInvalidSignature},
+		{"v3 too old", signedRequest(t, "v3", body, now.Add(-DefaultMaxAge-time.Minute)), body, ErrRequestExpired},
+		{"v3 in the future", signedRequest(t, "v3", body, now.Add(DefaultMaxAge+time.Minute)), body, ErrRequestInFuture},
+		{"v3 missing timestamp", func() *http.Request {
+			r := signedRequest(t, "v3", body, now)
+			r.Header.Del(HeaderRequestTimestamp)
+			return r
+		}(), body, ErrInvalidTimestamp},
+		{"v3 unparsable timestamp", func() *http.Request {
+			r := signedRequest(t, "v3", body, now)
+			r.Header.Set(HeaderRequestTimestamp, "yesterday")
+			return r
+		}(), body, ErrInvalidTimestamp},
+		{"unknown version", func() *http.Request {
+			r := signedRequest(t, "v1", body, now)
+			r.Header.Set(HeaderSignatureVersion, "v9")
+			return r
+		}(), body, ErrInvalidSignature},
+		{"unsigned", httptest.NewRequest(http.MethodPost, testURI, nil), body, ErrMissingSignature},
+	}
+	for _, tt := range tests {
+		if err := Validate(tt.r, testURI, tt.body, testSecret, DefaultMaxAge); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestValidateAllowsSmallClockSkew(t *testing.T) {
+	body := readFixture(t)
+	r := signedRequest(t, "v3", body, time.Now().Add(30*time.Second))
+	if err := Validate(r, testURI, body, testSecret, DefaultMaxAge); err != nil {
+		t.Fatal(err)
+	}
+}